@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"math/rand"
+	"time"
 
 	"github.com/gnailuy/sudoku/generator"
 	"github.com/spf13/pflag"
@@ -32,6 +34,10 @@ var levelIdentities = map[Level][]string{
 type CommandLineOptions struct {
 	Input         *string
 	Level         *enumflag.EnumFlagValue[Level]
+	Timeout       *time.Duration
+	Seed          *int64
+	Daily         *bool
+	Date          *string
 	HelpRequested *bool
 }
 
@@ -40,6 +46,10 @@ func NewCommandLineOptions() CommandLineOptions {
 	return CommandLineOptions{
 		Input:         nil,
 		Level:         new(enumflag.EnumFlagValue[Level]),
+		Timeout:       new(time.Duration),
+		Seed:          new(int64),
+		Daily:         new(bool),
+		Date:          new(string),
 		HelpRequested: new(bool),
 	}
 }
@@ -54,6 +64,16 @@ func (options *CommandLineOptions) Parse() {
 	options.Level = enumflag.New(&defaultLevel, "level", levelIdentities, enumflag.EnumCaseInsensitive)
 	pflag.VarP(options.Level, "level", "l", "Select the difficulty level for a new game. Options include: easy, medium, hard, extreme, evil.")
 
+	// Accept an optional timeout to abort a long generation or solution counting.
+	options.Timeout = pflag.DurationP("timeout", "t", 0, "Abort the generation or the solution counting after this duration, e.g. 30s. No timeout by default.")
+
+	// Accept an optional seed to reproduce a generated problem.
+	options.Seed = pflag.Int64P("seed", "s", 0, "Specify the seed of the random generation to reproduce a problem. If not provided, a random seed will be used and printed.")
+
+	// Accept an optional flag to play the problem of the day, the same for everyone on the same date and level.
+	options.Daily = pflag.BoolP("daily", "d", false, "Play the problem of the day for the difficulty level. The seed option is ignored.")
+	options.Date = pflag.String("date", "", "Play the daily problem of another date, in the format YYYY-MM-DD.")
+
 	// Define the help message.
 	options.HelpRequested = pflag.BoolP("help", "h", false, "Show this help message.")
 
@@ -66,6 +86,34 @@ func PrintHelp() {
 	pflag.PrintDefaults()
 }
 
+// Function to get the seed of the random generation: the seed option if provided, otherwise a random one.
+func (options *CommandLineOptions) GetSeed() int64 {
+	if pflag.CommandLine.Changed("seed") {
+		return *options.Seed
+	}
+
+	return rand.Int63()
+}
+
+// Function to check if the daily problem is requested, by the daily or the date option.
+func (options *CommandLineOptions) IsDaily() bool {
+	return *options.Daily || *options.Date != ""
+}
+
+// Function to get the date of the daily problem in UTC: the date option if provided, otherwise today.
+func (options *CommandLineOptions) GetDailyDate() (time.Time, error) {
+	if *options.Date == "" {
+		return time.Now().UTC(), nil
+	}
+
+	date, err := time.Parse(time.DateOnly, *options.Date)
+	if err != nil {
+		return date, fmt.Errorf("invalid date %s: %w", *options.Date, err)
+	}
+
+	return date, nil
+}
+
 // Function to create the difficulty options based on the command line flags.
 func (options *CommandLineOptions) GetDifficultyOptions() generator.SudokuDifficulty {
 	level := options.Level.Get()
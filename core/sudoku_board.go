@@ -58,8 +58,13 @@ func (board *SudokuBoard) Get(position Position) int {
 
 // Function to get a random position satisfying the value validator.
 func (board *SudokuBoard) GetRandomPositionWith(validator func(int) bool) *Position {
-	rowOrder := util.GenerateNumberArray(0, 9, true)
-	columnOrder := util.GenerateNumberArray(0, 9, true)
+	return board.GetRandomPositionWithSource(util.GlobalRandomSource, validator)
+}
+
+// Function to get a random position satisfying the value validator, chosen with the random source.
+func (board *SudokuBoard) GetRandomPositionWithSource(source util.IRandomSource, validator func(int) bool) *Position {
+	rowOrder := util.GenerateNumberArrayWith(source, 0, 9, true)
+	columnOrder := util.GenerateNumberArrayWith(source, 0, 9, true)
 	for _, row := range rowOrder {
 		for _, column := range columnOrder {
 			position := NewPosition(row, column)
@@ -0,0 +1,205 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Define the candidate grid struct, a Sudoku board with the pencil marks of every empty cell.
+type CandidateGrid struct {
+	board      SudokuBoard
+	candidates [9][9]CandidateSet
+}
+
+// Constructor like function to create a candidate grid from a Sudoku board.
+// The candidates of every empty cell are the values not yet used by its peers.
+func NewCandidateGrid(board SudokuBoard) CandidateGrid {
+	grid := CandidateGrid{board: board.Copy()}
+
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			grid.resetCandidates(NewPosition(row, column))
+		}
+	}
+
+	return grid
+}
+
+// Function to recompute the candidates of a position from the board.
+func (grid *CandidateGrid) resetCandidates(position Position) {
+	grid.candidates[position.Row][position.Column] = 0
+	if grid.board.Get(position) != 0 {
+		return
+	}
+
+	for value := 1; value <= 9; value++ {
+		if grid.board.IsValidInput(position, value) {
+			grid.candidates[position.Row][position.Column] = grid.candidates[position.Row][position.Column].Add(value)
+		}
+	}
+}
+
+// Function to set the value to a position and remove the value from the candidates of its peers.
+func (grid *CandidateGrid) Set(position Position, value int) (err error) {
+	if err = grid.board.Set(position, value); err != nil {
+		return err
+	}
+
+	grid.candidates[position.Row][position.Column] = 0
+	for _, peer := range position.GetPeers() {
+		grid.candidates[peer.Row][peer.Column] = grid.candidates[peer.Row][peer.Column].Remove(value)
+	}
+
+	return nil
+}
+
+// Function to set the value of a cell.
+func (grid *CandidateGrid) SetCell(cell Cell) (err error) {
+	if !cell.IsValid() {
+		return errors.New("cannot set invalid cell: " + cell.ToString())
+	}
+
+	return grid.Set(cell.Position, cell.Value)
+}
+
+// Function to unset the value of a position and give the value back to the peers where it fits again.
+// Note that eliminations made earlier on the peers for this value are not remembered.
+func (grid *CandidateGrid) Unset(position Position) {
+	value := grid.board.Get(position)
+	if value == 0 {
+		return
+	}
+
+	grid.board.Unset(position)
+	grid.resetCandidates(position)
+
+	for _, peer := range position.GetPeers() {
+		if grid.board.Get(peer) == 0 && grid.board.IsValidInput(peer, value) {
+			grid.candidates[peer.Row][peer.Column] = grid.candidates[peer.Row][peer.Column].Add(value)
+		}
+	}
+}
+
+// Function to remove a candidate from a position, return true if the candidate was present.
+func (grid *CandidateGrid) Eliminate(position Position, value int) bool {
+	if !grid.HasCandidate(position, value) {
+		return false
+	}
+
+	grid.candidates[position.Row][position.Column] = grid.candidates[position.Row][position.Column].Remove(value)
+
+	return true
+}
+
+// Function to get the value of a position.
+func (grid *CandidateGrid) Get(position Position) int {
+	return grid.board.Get(position)
+}
+
+// Function to get the candidates of a position. Filled positions have no candidates.
+func (grid *CandidateGrid) GetCandidates(position Position) CandidateSet {
+	return grid.candidates[position.Row][position.Column]
+}
+
+// Function to check if a value is a candidate of a position.
+func (grid *CandidateGrid) HasCandidate(position Position, value int) bool {
+	return grid.candidates[position.Row][position.Column].Contains(value)
+}
+
+// Function to get the positions in a house where the value is still a candidate.
+func (grid *CandidateGrid) GetPositionsWithCandidate(house House, value int) []Position {
+	positions := make([]Position, 0, 9)
+	for _, position := range house.GetPositions() {
+		if grid.HasCandidate(position, value) {
+			positions = append(positions, position)
+		}
+	}
+
+	return positions
+}
+
+// Function to get the empty positions of a house.
+func (grid *CandidateGrid) GetEmptyPositions(house House) []Position {
+	positions := make([]Position, 0, 9)
+	for _, position := range house.GetPositions() {
+		if grid.Get(position) == 0 {
+			positions = append(positions, position)
+		}
+	}
+
+	return positions
+}
+
+// Function to check if a value is already placed in a house.
+func (grid *CandidateGrid) IsPlacedIn(house House, value int) bool {
+	for _, position := range house.GetPositions() {
+		if grid.Get(position) == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to check if the grid is in a dead end: an empty cell without candidates,
+// or a value that can no longer be placed in a house.
+func (grid *CandidateGrid) HasContradiction() bool {
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := NewPosition(row, column)
+			if grid.Get(position) == 0 && grid.GetCandidates(position).IsEmpty() {
+				return true
+			}
+		}
+	}
+
+	for _, house := range AllHouses() {
+		for value := 1; value <= 9; value++ {
+			if !grid.IsPlacedIn(house, value) && len(grid.GetPositionsWithCandidate(house, value)) == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Function to get a copy of the underlying board.
+func (grid *CandidateGrid) GetBoard() SudokuBoard {
+	return grid.board.Copy()
+}
+
+// Function to check if the underlying board is solved.
+func (grid *CandidateGrid) IsSolved() bool {
+	return grid.board.IsSolved()
+}
+
+// Function to return a copy of the candidate grid.
+func (grid *CandidateGrid) Copy() CandidateGrid {
+	return CandidateGrid{
+		board:      grid.board.Copy(),
+		candidates: grid.candidates,
+	}
+}
+
+// Function to print the candidates of every cell, filled cells are printed as their values.
+func (grid *CandidateGrid) ToString() string {
+	result := ""
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := NewPosition(row, column)
+			if column > 0 {
+				result += " "
+			}
+
+			if value := grid.Get(position); value != 0 {
+				result += fmt.Sprint(value)
+			} else {
+				result += grid.GetCandidates(position).ToString()
+			}
+		}
+		result += "\n"
+	}
+
+	return result
+}
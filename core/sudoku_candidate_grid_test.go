@@ -0,0 +1,86 @@
+package core
+
+import "testing"
+
+// Test the NewCandidateGrid function.
+func TestNewCandidateGrid(t *testing.T) {
+	board := NewEmptySudokuBoard()
+	board.FromString("583.67..46723.48...4.8253.6934..852.2.74519.3851.3.4673..589742.952461.84.87..659")
+	grid := NewCandidateGrid(board)
+
+	tests := []struct {
+		position   Position
+		candidates CandidateSet
+	}{
+		{NewPosition(0, 0), NewCandidateSet()},        // Filled cell.
+		{NewPosition(0, 6), NewCandidateSet(2)},       // Naked single.
+		{NewPosition(0, 7), NewCandidateSet(1, 9)},    // Two candidates.
+		{NewPosition(2, 7), NewCandidateSet(1, 7, 9)}, // Three candidates.
+	}
+
+	for _, test := range tests {
+		if grid.GetCandidates(test.position) != test.candidates {
+			t.Errorf("Expected candidates at %s: %s, got %s", test.position.ToString(), test.candidates.ToString(), grid.GetCandidates(test.position).ToString())
+		}
+	}
+
+	if grid.HasContradiction() {
+		t.Error("The candidate grid has no contradiction")
+	}
+}
+
+// Test the Set, Unset and Eliminate functions.
+func TestCandidateGridSetAndUnset(t *testing.T) {
+	grid := NewCandidateGrid(NewEmptySudokuBoard())
+	position := NewPosition(4, 4)
+
+	grid.Set(position, 5)
+
+	if grid.Get(position) != 5 || !grid.GetCandidates(position).IsEmpty() {
+		t.Errorf("Expected value 5 without candidates at %s", position.ToString())
+	}
+
+	for _, peer := range position.GetPeers() {
+		if grid.HasCandidate(peer, 5) {
+			t.Errorf("Expected 5 to be removed from peer %s", peer.ToString())
+		}
+	}
+
+	if !grid.HasCandidate(NewPosition(0, 0), 5) {
+		t.Error("Expected 5 to remain a candidate outside of the peers")
+	}
+
+	grid.Unset(position)
+
+	if grid.Get(position) != 0 || grid.GetCandidates(position) != AllCandidates {
+		t.Errorf("Expected all candidates at %s after unset, got %s", position.ToString(), grid.GetCandidates(position).ToString())
+	}
+
+	for _, peer := range position.GetPeers() {
+		if !grid.HasCandidate(peer, 5) {
+			t.Errorf("Expected 5 to be restored in peer %s", peer.ToString())
+		}
+	}
+
+	if !grid.Eliminate(position, 3) || grid.Eliminate(position, 3) {
+		t.Error("Expected the first elimination to succeed and the second one to fail")
+	}
+}
+
+// Test the GetPositionsWithCandidate function.
+func TestGetPositionsWithCandidate(t *testing.T) {
+	grid := NewCandidateGrid(NewEmptySudokuBoard())
+	grid.Set(NewPosition(0, 0), 1)
+	grid.Set(NewPosition(4, 5), 1)
+
+	positions := grid.GetPositionsWithCandidate(NewBoxHouse(3), 1)
+	if len(positions) != 4 {
+		t.Errorf("Expected 4 positions for 1 in box 4, got %d", len(positions))
+	}
+
+	for _, position := range positions {
+		if position.Column == 0 || position.Row == 4 {
+			t.Errorf("Unexpected position for 1 in box 4: %s", position.ToString())
+		}
+	}
+}
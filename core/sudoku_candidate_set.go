@@ -0,0 +1,97 @@
+package core
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Define the candidate set type as a bitmask, bit d is set if the digit d is a candidate.
+type CandidateSet uint16
+
+// The candidate set containing all the digits from 1 to 9.
+const AllCandidates CandidateSet = 0b1111111110
+
+// Constructor like function to create a candidate set from a list of digits.
+func NewCandidateSet(digits ...int) CandidateSet {
+	set := CandidateSet(0)
+	for _, digit := range digits {
+		set = set.Add(digit)
+	}
+
+	return set
+}
+
+// Function to check if a digit is in the candidate set.
+func (set CandidateSet) Contains(digit int) bool {
+	return set&(1<<digit) != 0
+}
+
+// Function to return a new candidate set with the digit added.
+func (set CandidateSet) Add(digit int) CandidateSet {
+	if digit < 1 || digit > 9 {
+		panic("Bug: Invalid candidate digit: " + fmt.Sprint(digit))
+	}
+
+	return set | 1<<digit
+}
+
+// Function to return a new candidate set with the digit removed.
+func (set CandidateSet) Remove(digit int) CandidateSet {
+	return set &^ (1 << digit)
+}
+
+// Function to return the union of two candidate sets.
+func (set CandidateSet) Union(other CandidateSet) CandidateSet {
+	return set | other
+}
+
+// Function to return the intersection of two candidate sets.
+func (set CandidateSet) Intersect(other CandidateSet) CandidateSet {
+	return set & other
+}
+
+// Function to return the digits in the set but not in the other set.
+func (set CandidateSet) Difference(other CandidateSet) CandidateSet {
+	return set &^ other
+}
+
+// Function to get the number of digits in the candidate set.
+func (set CandidateSet) Count() int {
+	return bits.OnesCount16(uint16(set))
+}
+
+// Function to check if the candidate set is empty.
+func (set CandidateSet) IsEmpty() bool {
+	return set == 0
+}
+
+// Function to get the digits in the candidate set in ascending order.
+func (set CandidateSet) Digits() []int {
+	digits := make([]int, 0, set.Count())
+	for digit := 1; digit <= 9; digit++ {
+		if set.Contains(digit) {
+			digits = append(digits, digit)
+		}
+	}
+
+	return digits
+}
+
+// Function to get the smallest digit in the candidate set, or 0 if the set is empty.
+func (set CandidateSet) First() int {
+	if set.IsEmpty() {
+		return 0
+	}
+
+	return bits.TrailingZeros16(uint16(set))
+}
+
+// Function to print the candidate set as a string of digits, e.g. {125}.
+func (set CandidateSet) ToString() string {
+	result := "{"
+	for _, digit := range set.Digits() {
+		result += fmt.Sprint(digit)
+	}
+
+	return result + "}"
+}
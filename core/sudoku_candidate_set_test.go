@@ -0,0 +1,41 @@
+package core
+
+import "testing"
+
+// Test the basic operations of the CandidateSet type.
+func TestCandidateSet(t *testing.T) {
+	set := NewCandidateSet(1, 5, 9)
+
+	if set.Count() != 3 {
+		t.Errorf("Expected count: 3, got %d", set.Count())
+	}
+
+	if !set.Contains(5) || set.Contains(2) {
+		t.Errorf("Unexpected candidates in %s", set.ToString())
+	}
+
+	if set.ToString() != "{159}" {
+		t.Errorf("Expected {159}, got %s", set.ToString())
+	}
+
+	if set.First() != 1 {
+		t.Errorf("Expected first candidate: 1, got %d", set.First())
+	}
+
+	set = set.Remove(1).Add(2)
+	if set != NewCandidateSet(2, 5, 9) {
+		t.Errorf("Expected {259}, got %s", set.ToString())
+	}
+
+	if set.Intersect(NewCandidateSet(5, 6)) != NewCandidateSet(5) {
+		t.Errorf("Expected intersection {5}, got %s", set.Intersect(NewCandidateSet(5, 6)).ToString())
+	}
+
+	if set.Difference(NewCandidateSet(5, 6)) != NewCandidateSet(2, 9) {
+		t.Errorf("Expected difference {29}, got %s", set.Difference(NewCandidateSet(5, 6)).ToString())
+	}
+
+	if AllCandidates.Count() != 9 || !NewCandidateSet().IsEmpty() {
+		t.Errorf("Unexpected full or empty candidate set")
+	}
+}
@@ -0,0 +1,97 @@
+package core
+
+import "fmt"
+
+// Define the house types: a row, a column or a 3x3 sub-grid (box).
+type HouseType int
+
+const (
+	RowHouse HouseType = iota
+	ColumnHouse
+	BoxHouse
+)
+
+// Define the struct for a house, a group of 9 positions that must contain all the digits.
+type House struct {
+	Type  HouseType
+	Index int // 0-indexed. Boxes are numbered from left to right, top to bottom.
+}
+
+// Constructor like function to create a row house.
+func NewRowHouse(row int) House {
+	return NewHouse(RowHouse, row)
+}
+
+// Constructor like function to create a column house.
+func NewColumnHouse(column int) House {
+	return NewHouse(ColumnHouse, column)
+}
+
+// Constructor like function to create a box house.
+func NewBoxHouse(box int) House {
+	return NewHouse(BoxHouse, box)
+}
+
+// Constructor like function to create a house of any type.
+func NewHouse(houseType HouseType, index int) House {
+	if index < 0 || index >= 9 {
+		panic("Bug: Invalid house index: " + fmt.Sprint(index))
+	}
+
+	return House{Type: houseType, Index: index}
+}
+
+// Function to get all the 27 houses: rows first, then columns, then boxes.
+func AllHouses() []House {
+	houses := make([]House, 0, 27)
+	for _, houseType := range []HouseType{RowHouse, ColumnHouse, BoxHouse} {
+		for i := 0; i < 9; i++ {
+			houses = append(houses, NewHouse(houseType, i))
+		}
+	}
+
+	return houses
+}
+
+// Function to get the 9 positions of the house.
+func (house House) GetPositions() []Position {
+	positions := make([]Position, 9)
+	for i := 0; i < 9; i++ {
+		switch house.Type {
+		case RowHouse:
+			positions[i] = NewPosition(house.Index, i)
+		case ColumnHouse:
+			positions[i] = NewPosition(i, house.Index)
+		case BoxHouse:
+			positions[i] = NewPosition(house.Index/3*3+i/3, house.Index%3*3+i%3)
+		}
+	}
+
+	return positions
+}
+
+// Function to check if a position belongs to the house.
+func (house House) Contains(position Position) bool {
+	switch house.Type {
+	case RowHouse:
+		return position.Row == house.Index
+	case ColumnHouse:
+		return position.Column == house.Index
+	case BoxHouse:
+		return position.GetBox() == house.Index
+	}
+
+	return false
+}
+
+// Function to print the house as a user facing name, 1-indexed.
+func (house House) ToString() string {
+	switch house.Type {
+	case RowHouse:
+		return fmt.Sprintf("row %d", house.Index+1)
+	case ColumnHouse:
+		return fmt.Sprintf("column %d", house.Index+1)
+	default:
+		return fmt.Sprintf("box %d", house.Index+1)
+	}
+}
@@ -0,0 +1,29 @@
+package core
+
+import "testing"
+
+// Test the GetPositions and Contains functions.
+func TestHousePositions(t *testing.T) {
+	houses := AllHouses()
+	if len(houses) != 27 {
+		t.Errorf("Expected 27 houses, got %d", len(houses))
+	}
+
+	for _, house := range houses {
+		positions := house.GetPositions()
+		if len(positions) != 9 {
+			t.Errorf("Expected 9 positions in %s, got %d", house.ToString(), len(positions))
+		}
+
+		for _, position := range positions {
+			if !house.Contains(position) {
+				t.Errorf("Expected %s to contain %s", house.ToString(), position.ToString())
+			}
+		}
+	}
+
+	box := NewBoxHouse(5)
+	if box.GetPositions()[0] != NewPosition(3, 6) || box.GetPositions()[8] != NewPosition(5, 8) {
+		t.Errorf("Unexpected positions in %s", box.ToString())
+	}
+}
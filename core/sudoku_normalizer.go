@@ -28,11 +28,16 @@ func (board *SudokuBoard) Normalize() {
 
 // Function to randomize a normalized Sudoku board.
 func (board *SudokuBoard) Randomize() {
+	board.RandomizeWith(util.GlobalRandomSource)
+}
+
+// Function to randomize a normalized Sudoku board with the random source.
+func (board *SudokuBoard) RandomizeWith(source util.IRandomSource) {
 	// Make a copy of the board.
 	boardCopy := board.Copy()
 
 	// Randomize the board with the below replacement plan.
-	randomArray := util.GenerateNumberArray(1, 10, true)
+	randomArray := util.GenerateNumberArrayWith(source, 1, 10, true)
 
 	for j := 0; j < 9; j++ {
 		for k := 0; k < 9; k++ {
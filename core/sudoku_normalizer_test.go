@@ -63,3 +63,18 @@ func TestRandomizeUnsolvedBoard(t *testing.T) {
 		t.Error("Randomization failed: the board is not valid after randomization")
 	}
 }
+
+// Test the RandomizeWith function gives the same board with the same seed.
+func TestRandomizeWithSeed(t *testing.T) {
+	input := "123456789567389241498271365839562174756914823214837956345128697681795432972643518"
+	first, second := NewEmptySudokuBoard(), NewEmptySudokuBoard()
+	first.FromString(input)
+	second.FromString(input)
+
+	first.RandomizeWith(util.NewSeededRandomSource(42))
+	second.RandomizeWith(util.NewSeededRandomSource(42))
+
+	if first.ToString() != second.ToString() {
+		t.Errorf("Expected the same randomized board with the same seed, got %s and %s", first.ToString(), second.ToString())
+	}
+}
@@ -46,3 +46,51 @@ func (position *Position) IsValid() bool {
 func (position *Position) ToString() string {
 	return fmt.Sprintf("(%d, %d)", position.Row+1, position.Column+1)
 }
+
+// Function to get the 0-indexed box of the position, numbered from left to right, top to bottom.
+func (position *Position) GetBox() int {
+	return position.Row/3*3 + position.Column/3
+}
+
+// Function to get the row, column and box houses containing the position.
+func (position *Position) GetHouses() [3]House {
+	return [3]House{NewRowHouse(position.Row), NewColumnHouse(position.Column), NewBoxHouse(position.GetBox())}
+}
+
+// Function to check if another position shares a row, column or box with this position.
+// A position is not a peer of itself.
+func (position *Position) IsPeerOf(other Position) bool {
+	if *position == other {
+		return false
+	}
+
+	return position.Row == other.Row || position.Column == other.Column || position.GetBox() == other.GetBox()
+}
+
+// Precomputed peers of every position.
+var peerPositions = buildPeerPositions()
+
+// Function to build the peer positions of every cell, the other cells sharing its row, column or box.
+func buildPeerPositions() (peers [9][9][]Position) {
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := NewPosition(row, column)
+			for otherRow := 0; otherRow < 9; otherRow++ {
+				for otherColumn := 0; otherColumn < 9; otherColumn++ {
+					other := NewPosition(otherRow, otherColumn)
+					if position.IsPeerOf(other) {
+						peers[row][column] = append(peers[row][column], other)
+					}
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// Function to get the 20 peers of the position.
+// The returned slice is shared, callers must not modify it.
+func (position *Position) GetPeers() []Position {
+	return peerPositions[position.Row][position.Column]
+}
@@ -96,3 +96,33 @@ func TestNewPositionFromInputInvalid(t *testing.T) {
 		})
 	}
 }
+
+// Test the GetBox and GetPeers functions.
+func TestGetBoxAndPeers(t *testing.T) {
+	tests := []struct {
+		position Position
+		box      int
+	}{
+		{NewPosition(0, 0), 0},
+		{NewPosition(4, 4), 4},
+		{NewPosition(5, 7), 5},
+		{NewPosition(8, 2), 6},
+	}
+
+	for _, test := range tests {
+		if test.position.GetBox() != test.box {
+			t.Errorf("Expected box of %s: %d, got %d", test.position.ToString(), test.box, test.position.GetBox())
+		}
+
+		peers := test.position.GetPeers()
+		if len(peers) != 20 {
+			t.Errorf("Expected 20 peers of %s, got %d", test.position.ToString(), len(peers))
+		}
+
+		for _, peer := range peers {
+			if peer == test.position || !test.position.IsPeerOf(peer) {
+				t.Errorf("Unexpected peer of %s: %s", test.position.ToString(), peer.ToString())
+			}
+		}
+	}
+}
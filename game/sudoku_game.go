@@ -1,6 +1,7 @@
 package game
 
 import (
+	"context"
 	"errors"
 
 	"github.com/gnailuy/sudoku/core"
@@ -45,8 +46,9 @@ func NewSudokuGame(problem core.SudokuBoard, options SudokuGameOptions) SudokuGa
 }
 
 // Function to count the solutions of the current play board using the default solver.
+// The game only needs to tell 0, 1 or more solutions, so the counting stops at 2.
 func (game *SudokuGame) countSolutions() int {
-	return game.defaultSolver.CountSolutions(&game.PlayBoard)
+	return game.defaultSolver.CountSolutionsUpTo(&game.PlayBoard, 2)
 }
 
 // Function to add a non-zero cell input.
@@ -197,8 +199,21 @@ func (game *SudokuGame) Solve() {
 	game.defaultSolver.Solve(&game.PlayBoard)
 }
 
+// Function to solve the game, aborting with an error when the context is done. The play board is left untouched on error.
+func (game *SudokuGame) SolveContext(ctx context.Context) error {
+	_, err := game.defaultSolver.SolveContext(ctx, &game.PlayBoard)
+	return err
+}
+
 // Function to get a hint of the game.
 func (game *SudokuGame) Hint() *core.Cell {
+	hint, _ := game.HintWithSteps()
+	return hint
+}
+
+// Function to get a hint of the game with the strategy steps explaining it.
+// The steps are empty if the hint is not given by a strategy solver.
+func (game *SudokuGame) HintWithSteps() (*core.Cell, []solver.SolveStep) {
 	// If there is any invalid input, randomly remove one of them.
 	if !game.invalidInput.IsEmpty() {
 		positionPointer := game.invalidInput.GetRandomPositionWith(func(value int) bool {
@@ -212,19 +227,23 @@ func (game *SudokuGame) Hint() *core.Cell {
 		return &core.Cell{
 			Position: *positionPointer,
 			Value:    0,
-		}
+		}, nil
 	}
 
 	// If any of the strategy solvers can give a hint, use it.
-	for _, solver := range game.strategySolvers {
-		hint := solver.Hint(&game.PlayBoard)
-		if hint != nil {
-			return hint
+	for _, strategySolver := range game.strategySolvers {
+		if explainingSolver, ok := strategySolver.(solver.IStrategySolver); ok {
+			steps := explainingSolver.ExplainHint(&game.PlayBoard)
+			if len(steps) > 0 {
+				return &steps[len(steps)-1].Placements[0], steps
+			}
+		} else if hint := strategySolver.Hint(&game.PlayBoard); hint != nil {
+			return hint, nil
 		}
 	}
 
 	// Otherwise, get a hint from the default solver.
-	return game.defaultSolver.Hint(&game.PlayBoard)
+	return game.defaultSolver.Hint(&game.PlayBoard), nil
 }
 
 // Function to check if the game is solved.
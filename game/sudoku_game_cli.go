@@ -2,6 +2,7 @@ package game
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -144,7 +145,7 @@ func (game *SudokuGame) runCommandWithArguments(commandFields []string) (success
 }
 
 // Function to run a command.
-func (game *SudokuGame) runCommand(command string, closeChannel cli.CloseChannel) bool {
+func (game *SudokuGame) runCommand(ctx context.Context, command string, closeChannel cli.CloseChannel) bool {
 	commandFields := strings.SplitN(command, " ", 2)
 
 	// Empty command, return directly.
@@ -178,13 +179,17 @@ func (game *SudokuGame) runCommand(command string, closeChannel cli.CloseChannel
 	case "repair", "f":
 		return game.Repair() > 0
 	case "hint", "i":
-		hint := game.Hint()
+		hint, steps := game.HintWithSteps()
 		if hint != nil {
 			added, err := game.setValue(hint.Position.Row+1, hint.Position.Column+1, hint.Value)
 			if err != nil {
 				printError("Failed to apply hint:", err)
 			}
 			if added {
+				for _, step := range steps {
+					fmt.Println("Step:", step.ToString())
+				}
+
 				if hint.Value != 0 {
 					fmt.Printf("Hint: Added %d to cell (%d, %d)\n", hint.Value, hint.Position.Row+1, hint.Position.Column+1)
 				} else {
@@ -195,7 +200,10 @@ func (game *SudokuGame) runCommand(command string, closeChannel cli.CloseChannel
 		}
 		return false
 	case "solve", "s":
-		game.Solve()
+		if err := game.SolveContext(ctx); err != nil {
+			printError("Failed to solve the problem:", err)
+			return false
+		}
 		return true
 	case "reset", "e":
 		game.Reset()
@@ -238,8 +246,8 @@ func (game *SudokuGame) askUserInput(scanner *bufio.Scanner, inputChannel chan s
 	}
 }
 
-// Function to start the game.
-func (game *SudokuGame) PlayCli() {
+// Function to start the game. A long running command is aborted when the context is done.
+func (game *SudokuGame) PlayCli(ctx context.Context) {
 	inputChannel := make(chan string)
 	closeChannel := cli.NewCloseChannel()
 
@@ -251,7 +259,7 @@ func (game *SudokuGame) PlayCli() {
 		// Block until we receive a command or the close channel is closed.
 		select {
 		case command := <-inputChannel:
-			game.runCommand(command, closeChannel)
+			game.runCommand(ctx, command, closeChannel)
 		case <-closeChannel:
 			fmt.Println("\nExiting the game.")
 			fmt.Println(game.ToString())
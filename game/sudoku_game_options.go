@@ -14,9 +14,10 @@ type SudokuGameOptions struct {
 }
 
 // Constructor like function to create a default options object.
+// Only the cheap strategy solvers give hints by default, the slower techniques must be added to the keys.
 func NewDefaultSudokuGameOptions(solverStore solver.SudokuSolverStore) SudokuGameOptions {
 	return SudokuGameOptions{
-		StrategySolverKeys: []string{},
+		StrategySolverKeys: []string{"singles", "locked-candidates", "subsets"},
 		solverStore:        solverStore,
 	}
 }
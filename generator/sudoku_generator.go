@@ -1,14 +1,40 @@
 package generator
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"time"
 
 	"github.com/gnailuy/sudoku/core"
+	"github.com/gnailuy/sudoku/solver"
 	"github.com/gnailuy/sudoku/util"
 )
 
+// Function to get the default solver of the store, making its random choices with the random source of the options.
+func getDefaultSolver(options SudokuGeneratorOptions) solver.ISudokuSolver {
+	defaultSolver := options.solverStore.GetDefaultSolver()
+	if randomizedSolver, ok := defaultSolver.(solver.IRandomizedSolver); ok {
+		return randomizedSolver.WithRandomSource(options.Random)
+	}
+
+	return defaultSolver
+}
+
 // Function to generate a solved Sudoku board by solving an empty normalized board randomly.
 func GenerateNormalizedSolvedBoard(options SudokuGeneratorOptions) core.SudokuBoard {
+	board, err := GenerateNormalizedSolvedBoardContext(context.Background(), options)
+	if err != nil {
+		panic("Bug: Failed to generate a solved board without a deadline: " + err.Error())
+	}
+
+	return board
+}
+
+// Function to generate a solved Sudoku board by solving an empty normalized board randomly, aborting when the context is done.
+func GenerateNormalizedSolvedBoardContext(ctx context.Context, options SudokuGeneratorOptions) (core.SudokuBoard, error) {
 	// The first row of a normalize empty board is always from 1 to 9.
 	board := core.NewEmptySudokuBoard()
 	for col := 0; col < 9; col++ {
@@ -16,18 +42,54 @@ func GenerateNormalizedSolvedBoard(options SudokuGeneratorOptions) core.SudokuBo
 	}
 
 	// To generate a solved board from an empty normalized board, we use the reliable default solver.
-	solver := options.solverStore.GetDefaultSolver()
-	solver.Solve(&board)
+	if _, err := getDefaultSolver(options).SolveContext(ctx, &board); err != nil {
+		return board, fmt.Errorf("solved board generation aborted: %w", err)
+	}
 
-	return board
+	return board, nil
 }
 
 // Function to generate a Sudoku problem from a solved board.
 func GenerateSudokuProblemFromSolvedBoard(board core.SudokuBoard, options SudokuGeneratorOptions) core.SudokuBoard {
+	problem, err := GenerateSudokuProblemFromSolvedBoardContext(context.Background(), board, options)
+	if err != nil {
+		panic("Bug: Failed to generate a problem without a deadline: " + err.Error())
+	}
+
+	return problem
+}
+
+// Function to generate a Sudoku problem from a solved board, aborting when the context is done.
+// On error, the returned board is the problem as it was when aborting.
+func GenerateSudokuProblemFromSolvedBoardContext(ctx context.Context, board core.SudokuBoard, options SudokuGeneratorOptions) (core.SudokuBoard, error) {
+	problem, _, err := generateRatedSudokuProblem(ctx, board, options)
+	return problem, err
+}
+
+// Function to generate a Sudoku problem from a solved board like GenerateSudokuProblemFromSolvedBoardContext,
+// also returning the rating of the problem if the difficulty is rated.
+func generateRatedSudokuProblem(ctx context.Context, board core.SudokuBoard, options SudokuGeneratorOptions) (core.SudokuBoard, solver.SudokuRating, error) {
 	if !board.IsSolved() || !board.IsValid() {
 		panic("Bug: The board is not solved or not valid to generate a problem")
 	}
 
+	strategySolvers, err := options.solverStore.GetStrategySolversByKeys(options.Difficulty.StrategySolverKeys)
+	if err != nil {
+		panic("Bug: Invalid strategy solver keys: " + err.Error())
+	}
+
+	// The rater only needs the techniques up to the maximum score to accept a removal.
+	// It applies the same steps as the full rater on the boards it solves, so their ratings are the same.
+	rater := solver.NewSudokuRater(options.solverStore)
+	_, maximumScore := getTargetScoreRange(options.Difficulty, rater)
+	rater = rater.WithMaximumScore(maximumScore)
+
+	// The rating of the problem, updated at every accepted removal.
+	var rating solver.SudokuRating
+	if options.Difficulty.IsRated() {
+		rating = rater.Rate(&board)
+	}
+
 	// Initially, all cells are filled.
 	nonEmptyPositions := make([]core.Position, 0)
 	for row := 0; row < 9; row++ {
@@ -52,7 +114,8 @@ func GenerateSudokuProblemFromSolvedBoard(board core.SudokuBoard, options Sudoku
 
 			// Use a simple geometric distribution to stop removing numbers with a probability of P.
 			// The expected number of iterations after the difficulty level is reached will be 1/P.
-			if util.RandomBool(0.125) {
+			// When the problem is rated, keep removing numbers to get as close as possible to the maximum score.
+			if !options.Difficulty.IsRated() && util.RandomBoolWith(options.Random, 0.125) {
 				break
 			}
 		}
@@ -63,7 +126,7 @@ func GenerateSudokuProblemFromSolvedBoard(board core.SudokuBoard, options Sudoku
 		}
 
 		// Test the non-empty positions in a random order and unset the first one that can be removed.
-		util.ShuffleArray(nonEmptyPositions)
+		util.ShuffleArrayWith(options.Random, nonEmptyPositions)
 
 		removedPositionIndex := -1
 		for j, position := range nonEmptyPositions {
@@ -73,39 +136,17 @@ func GenerateSudokuProblemFromSolvedBoard(board core.SudokuBoard, options Sudoku
 			// Update the board.
 			board.Unset(position)
 
-			// Find out the maximum number of solutions using the default solver.
-			numberOfSolutions := options.solverStore.GetDefaultSolver().CountSolutions(&board)
-
-			// Check if the problem is solvable and has no more than maximum solutions.
-			if numberOfSolutions > 0 && numberOfSolutions <= options.MaximumSolutions {
-				canHint := false
-
-				if len(options.Difficulty.StrategySolverKeys) > 0 {
-					// If there are strategy solvers configured, we limit the problem to be solvable with the specified strategies.
-					// Test the strategy solvers to ensure that at least one of them can give a hint.
-					for _, key := range options.Difficulty.StrategySolverKeys {
-						solver := options.solverStore.GetSolverByKey(key)
-						if solver == nil {
-							panic("Bug: Invalid strategy solver key: " + key)
-						}
-
-						hint := solver.Hint(&board)
-						if hint != nil {
-							canHint = true
-							break
-						}
-					}
-				} else {
-					// If there are no strategy solvers configured, we don't care about limiting the problem to specific strategies.
-					// And the default solver can always give a hint.
-					canHint = true
-				}
-
-				// Confirm the removal.
-				if canHint {
-					removedPositionIndex = j
-					break
-				}
+			// Confirm the removal if the problem is still acceptable.
+			acceptable, removalRating, err := isAcceptableProblem(ctx, &board, options, strategySolvers, rater)
+			if err != nil {
+				board.Set(position, originalValue)
+				return board, rating, fmt.Errorf("problem generation aborted: %w", err)
+			}
+
+			if acceptable {
+				rating = removalRating
+				removedPositionIndex = j
+				break
 			}
 
 			// If the problem is not solvable or has more than maximum solutions, revert the removal.
@@ -121,19 +162,140 @@ func GenerateSudokuProblemFromSolvedBoard(board core.SudokuBoard, options Sudoku
 		}
 	}
 
-	return board
+	return board, rating, nil
+}
+
+// Function to check if the problem is acceptable for the options while removing numbers, with its rating if the difficulty is rated.
+// With strategy solvers or a rating, the whole problem must be solved by them. Being solved logically, the problem has
+// a unique solution, so the solutions are not counted by the default solver and the maximum solutions is not used.
+func isAcceptableProblem(ctx context.Context, board *core.SudokuBoard, options SudokuGeneratorOptions, strategySolvers []solver.IStrategySolver, rater solver.SudokuRater) (acceptable bool, rating solver.SudokuRating, err error) {
+	if err = ctx.Err(); err != nil {
+		return false, rating, err
+	}
+
+	if len(strategySolvers) > 0 {
+		var trace solver.SolveTrace
+		if trace, err = solver.TraceSolveContext(ctx, board, strategySolvers); err != nil || !trace.Solved {
+			return false, rating, err
+		}
+	}
+
+	if options.Difficulty.IsRated() {
+		rating, err = rater.RateContext(ctx, board)
+		return err == nil && rating.Solved, rating, err
+	}
+
+	if len(strategySolvers) > 0 {
+		return true, rating, nil
+	}
+
+	// Otherwise, find out the number of solutions using the default solver, only up to one more than the maximum.
+	numberOfSolutions, err := getDefaultSolver(options).CountSolutionsUpToContext(ctx, board, options.MaximumSolutions+1)
+	if err != nil {
+		return false, rating, err
+	}
+
+	return numberOfSolutions > 0 && numberOfSolutions <= options.MaximumSolutions, rating, nil
+}
+
+// Function to get the score range of the difficulty: the score of the target technique if any, otherwise its score range.
+func getTargetScoreRange(difficulty SudokuDifficulty, rater solver.SudokuRater) (minimumScore float64, maximumScore float64) {
+	if difficulty.TargetTechnique == "" {
+		return difficulty.MinimumScore, difficulty.MaximumScore
+	}
+
+	score := rater.GetScore(difficulty.TargetTechnique)
+	if score == 0 {
+		panic("Bug: Invalid target technique: " + difficulty.TargetTechnique)
+	}
+
+	return score, score
+}
+
+// Define how far a generated problem is from the difficulty.
+type problemDistance struct {
+	missesTechnique bool    // If the difficulty targets a technique the problem does not use.
+	distance        float64 // The distance of the score to the score range if rated, otherwise of the number of clues to the clue range.
+}
+
+// Function to check if the problem meets the difficulty.
+func (distance problemDistance) isZero() bool {
+	return !distance.missesTechnique && distance.distance == 0
+}
+
+// Function to check if the problem is closer to the difficulty than the other one:
+// using the target technique comes first, whatever the distances, which only break the ties.
+func (distance problemDistance) isCloserThan(other problemDistance) bool {
+	if distance.missesTechnique != other.missesTechnique {
+		return other.missesTechnique
+	}
+
+	return distance.distance < other.distance
+}
+
+// Function to measure how far the rating is from the difficulty.
+func getRatingDistance(rating solver.SudokuRating, difficulty SudokuDifficulty, minimumScore float64, maximumScore float64) problemDistance {
+	return problemDistance{
+		missesTechnique: difficulty.TargetTechnique != "" && rating.TechniqueCounts[difficulty.TargetTechnique] == 0,
+		distance:        math.Max(minimumScore-rating.Score, 0) + math.Max(rating.Score-maximumScore, 0),
+	}
+}
+
+// Function to measure how far the number of clues is from the clue range of the difficulty, zero if it is within.
+func getCluesDistance(difficulty SudokuDifficulty, numberOfClues int) float64 {
+	return float64(max(difficulty.MinimumClues-numberOfClues, 0) + max(numberOfClues-difficulty.MaximumClues+1, 0))
 }
 
 // Function to generate a Sudoku problem.
+// New problems are generated until one is within the clue range of the difficulty, as the strategy solvers may not
+// allow removing enough clues. If the difficulty is rated, until the rater confirms one needs the target technique
+// or is within the score range instead. After the maximum attempts, the closest problem is returned.
 func GenerateSudokuProblem(options SudokuGeneratorOptions) core.SudokuBoard {
-	solvedBoard := GenerateNormalizedSolvedBoard(options)
-	solvedBoard.Randomize()
-
-	problem := GenerateSudokuProblemFromSolvedBoard(solvedBoard, options)
+	problem, err := GenerateSudokuProblemContext(context.Background(), options)
+	if err != nil {
+		panic("Bug: Failed to generate a problem without a deadline: " + err.Error())
+	}
 
 	return problem
 }
 
+// Function to generate a Sudoku problem like GenerateSudokuProblem, aborting with an error when the context is done.
+func GenerateSudokuProblemContext(ctx context.Context, options SudokuGeneratorOptions) (core.SudokuBoard, error) {
+	var problem core.SudokuBoard
+	var bestDistance problemDistance
+	rater := solver.NewSudokuRater(options.solverStore)
+	minimumScore, maximumScore := getTargetScoreRange(options.Difficulty, rater)
+
+	for attempt := 0; attempt < max(options.MaximumAttempts, 1); attempt++ {
+		solvedBoard, err := GenerateNormalizedSolvedBoardContext(ctx, options)
+		if err != nil {
+			return problem, err
+		}
+
+		solvedBoard.RandomizeWith(options.Random)
+
+		candidate, rating, err := generateRatedSudokuProblem(ctx, solvedBoard, options)
+		if err != nil {
+			return problem, err
+		}
+
+		distance := problemDistance{distance: getCluesDistance(options.Difficulty, candidate.GetFilledCellsCount())}
+		if options.Difficulty.IsRated() {
+			distance = getRatingDistance(rating, options.Difficulty, minimumScore, maximumScore)
+		}
+
+		if attempt == 0 || distance.isCloserThan(bestDistance) {
+			problem, bestDistance = candidate, distance
+		}
+
+		if distance.isZero() {
+			break
+		}
+	}
+
+	return problem, nil
+}
+
 // Function to generate a Sudoku problem from an input string.
 func GenerateSudokuProblemFromString(input string) (boardPointer *core.SudokuBoard, err error) {
 	if !core.IsValidSudokuString(input) {
@@ -151,3 +313,33 @@ func GenerateSudokuProblemFromString(input string) (boardPointer *core.SudokuBoa
 
 	return
 }
+
+// Function to get the seed of the daily problem of the date and the difficulty.
+// Only the calendar date in UTC and the name of the difficulty are used, so everyone gets the same seed on the same day.
+func GetDailySeed(date time.Time, difficulty SudokuDifficulty) int64 {
+	if difficulty.Name == "" {
+		panic("Bug: The daily problem needs a named difficulty")
+	}
+
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%s %s", date.UTC().Format(time.DateOnly), difficulty.Name)
+
+	return int64(hash.Sum64())
+}
+
+// Function to generate the daily problem of the date: the same date and options always give the same problem.
+func GenerateDailySudokuProblem(options SudokuGeneratorOptions, date time.Time) core.SudokuBoard {
+	problem, err := GenerateDailySudokuProblemContext(context.Background(), options, date)
+	if err != nil {
+		panic("Bug: Failed to generate a daily problem without a deadline: " + err.Error())
+	}
+
+	return problem
+}
+
+// Function to generate the daily problem of the date like GenerateDailySudokuProblem, aborting with an error when the context is done.
+func GenerateDailySudokuProblemContext(ctx context.Context, options SudokuGeneratorOptions, date time.Time) (core.SudokuBoard, error) {
+	options.Random = util.NewSeededRandomSource(GetDailySeed(date, options.Difficulty))
+
+	return GenerateSudokuProblemContext(ctx, options)
+}
@@ -1,51 +1,68 @@
 package generator
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Define the difficulty levels of a Sudoku problem.
 type SudokuDifficulty struct {
+	Name               string   // The stable name of the level, with its parameters for the custom levels.
 	MinimumClues       int      // Inclusive.
 	MaximumClues       int      // Exclusive.
-	StrategySolverKeys []string // Allowed strategies to solve the problem in this difficulty level. Empty means all strategies are allowed.
+	StrategySolverKeys []string // Allowed strategies to solve the whole problem, tried in order at every step. Empty means all strategies are allowed.
+	MinimumScore       float64  // Inclusive minimum score of the rater.
+	MaximumScore       float64  // Inclusive maximum score of the rater. Zero means the score is not targeted.
+	TargetTechnique    string   // The technique the problem must need, without needing any harder one. Overrides the score range if not empty.
 }
 
 // Constructor like function to create the easy difficulty level.
 func NewEasySudokuDifficulty() SudokuDifficulty {
 	return SudokuDifficulty{
+		Name:               "easy",
 		MinimumClues:       45,
 		MaximumClues:       60,
-		StrategySolverKeys: []string{},
+		StrategySolverKeys: []string{"singles"},
 	}
 }
 
 // Constructor like function to create the medium difficulty level.
 func NewMediumSudokuDifficulty() SudokuDifficulty {
 	return SudokuDifficulty{
+		Name:               "medium",
 		MinimumClues:       32,
 		MaximumClues:       45,
-		StrategySolverKeys: []string{},
+		StrategySolverKeys: []string{"singles", "locked-candidates"},
 	}
 }
 
 // Constructor like function to create the hard difficulty level.
 func NewHardSudokuDifficulty() SudokuDifficulty {
 	return SudokuDifficulty{
+		Name:               "hard",
 		MinimumClues:       25,
 		MaximumClues:       32,
-		StrategySolverKeys: []string{},
+		StrategySolverKeys: []string{"singles", "locked-candidates", "subsets"},
 	}
 }
 
 // Constructor like function to create the extreme difficulty level.
 func NewExtremeSudokuDifficulty() SudokuDifficulty {
 	return SudokuDifficulty{
+		Name:               "extreme",
 		MinimumClues:       20,
 		MaximumClues:       25,
-		StrategySolverKeys: []string{},
+		StrategySolverKeys: []string{"singles", "locked-candidates", "subsets", "xwing", "swordfish", "wings"},
 	}
 }
 
 // Constructor like function to create the evil difficulty level.
+// Evil is not rated on purpose: rating every removal with the chains, almost locked sets, Sue de Coq and forcing chains
+// takes minutes per problem. It relies on the number of clues and the default solver instead, and
+// NewScoredSudokuDifficulty can target the scores of these techniques when the wait is acceptable.
 func NewEvilSudokuDifficulty() SudokuDifficulty {
 	return SudokuDifficulty{
+		Name:               "evil",
 		MinimumClues:       17,
 		MaximumClues:       20,
 		StrategySolverKeys: []string{},
@@ -55,12 +72,46 @@ func NewEvilSudokuDifficulty() SudokuDifficulty {
 // Constructor like function to create the custom difficulty level.
 func NewCustomSudokuDifficulty(minimumClues int, maximumClues int, solverKeys []string) SudokuDifficulty {
 	return SudokuDifficulty{
+		Name:               fmt.Sprintf("custom %d-%d %s", minimumClues, maximumClues, strings.Join(solverKeys, ",")),
 		MinimumClues:       minimumClues,
 		MaximumClues:       maximumClues,
 		StrategySolverKeys: solverKeys,
 	}
 }
 
+// Constructor like function to create a difficulty level targeting a score range of the rater instead of a number of clues.
+func NewScoredSudokuDifficulty(minimumScore float64, maximumScore float64) SudokuDifficulty {
+	return SudokuDifficulty{
+		Name:               fmt.Sprintf("scored %g-%g", minimumScore, maximumScore),
+		MinimumClues:       17,
+		MaximumClues:       82,
+		StrategySolverKeys: []string{},
+		MinimumScore:       minimumScore,
+		MaximumScore:       maximumScore,
+	}
+}
+
+// Constructor like function to create a difficulty level targeting a technique: the problem must need it, and nothing harder.
+func NewTechniqueSudokuDifficulty(techniqueKey string) SudokuDifficulty {
+	return SudokuDifficulty{
+		Name:               "technique " + techniqueKey,
+		MinimumClues:       17,
+		MaximumClues:       82,
+		StrategySolverKeys: []string{},
+		TargetTechnique:    techniqueKey,
+	}
+}
+
+// Function to check if the problems of the difficulty level are rated, by a target technique or a score range.
+func (difficulty SudokuDifficulty) IsRated() bool {
+	return difficulty.TargetTechnique != "" || difficulty.HasScoreRange()
+}
+
+// Function to check if the difficulty level targets a score range of the rater.
+func (difficulty SudokuDifficulty) HasScoreRange() bool {
+	return difficulty.MaximumScore > 0
+}
+
 // Function to check if the number of clues is within the difficulty level.
 func (difficulty SudokuDifficulty) IsWithinDifficultyLevel(numberOfClues int) bool {
 	return numberOfClues >= difficulty.MinimumClues && numberOfClues < difficulty.MaximumClues
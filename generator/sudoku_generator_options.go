@@ -1,13 +1,18 @@
 package generator
 
-import "github.com/gnailuy/sudoku/solver"
+import (
+	"github.com/gnailuy/sudoku/solver"
+	"github.com/gnailuy/sudoku/util"
+)
 
 // Define the options to generate a Sudoku problem.
 type SudokuGeneratorOptions struct {
 	// Public fields.
-	MaximumSolutions  int
+	MaximumSolutions  int // Not used if the difficulty has strategy solvers or is rated, as the problems solved by them have a unique solution.
 	MaximumIterations int
+	MaximumAttempts   int // Maximum number of problems generated to reach the clue range, or the score range if rated, of the difficulty.
 	Difficulty        SudokuDifficulty
+	Random            util.IRandomSource // The source of all the random choices, a seeded source always generates the same problem.
 
 	// Private fields.
 	solverStore solver.SudokuSolverStore
@@ -18,7 +23,9 @@ func NewSudokuProblemOptions(solverStore solver.SudokuSolverStore, difficulty Su
 	return SudokuGeneratorOptions{
 		MaximumSolutions:  1,
 		MaximumIterations: 1024,
+		MaximumAttempts:   16,
 		Difficulty:        difficulty,
+		Random:            util.GlobalRandomSource,
 		solverStore:       solverStore,
 	}
 }
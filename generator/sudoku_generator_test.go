@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gnailuy/sudoku/solver"
+	"github.com/gnailuy/sudoku/util"
+)
+
+// Function to create a solver store with the dancing links solver as the default solver for the tests.
+func newTestSolverStore(t *testing.T) solver.SudokuSolverStore {
+	store := solver.NewSudokuSolverStore()
+	if err := store.SetDefaultSolver("dlx"); err != nil {
+		t.Fatal(err)
+	}
+
+	return store
+}
+
+// Test the extreme problems are within the clue range and solved by the strategy solvers of the level.
+func TestGenerateExtremeSudokuProblem(t *testing.T) {
+	store := newTestSolverStore(t)
+	difficulty := NewExtremeSudokuDifficulty()
+	strategySolvers, err := store.GetStrategySolversByKeys(difficulty.StrategySolverKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for seed := int64(1); seed <= 5; seed++ {
+		options := NewSudokuProblemOptions(store, difficulty)
+		options.Random = util.NewSeededRandomSource(seed)
+
+		problem := GenerateSudokuProblem(options)
+		if !difficulty.IsWithinDifficultyLevel(problem.GetFilledCellsCount()) {
+			t.Errorf("Seed %d: expected %d to %d clues, got %d", seed, difficulty.MinimumClues, difficulty.MaximumClues-1, problem.GetFilledCellsCount())
+		}
+
+		if trace := solver.TraceSolve(&problem, strategySolvers); !trace.Solved {
+			t.Errorf("Seed %d: expected the problem to be solved by the strategy solvers: %s", seed, problem.ToString())
+		}
+	}
+}
+
+// Test that a problem generated for a target technique needs it and nothing harder.
+func TestGenerateTechniqueSudokuProblem(t *testing.T) {
+	store := newTestSolverStore(t)
+	rater := solver.NewSudokuRater(store)
+
+	for _, technique := range []string{"locked-candidates", "xwing"} {
+		options := NewSudokuProblemOptions(store, NewTechniqueSudokuDifficulty(technique))
+		options.Random = util.NewSeededRandomSource(1)
+
+		problem := GenerateSudokuProblem(options)
+		rating := rater.Rate(&problem)
+		if !rating.Solved || rating.HardestTechnique != technique || rating.TechniqueCounts[technique] == 0 {
+			t.Errorf("Expected a problem needing %s at most, got %+v", technique, rating)
+		}
+	}
+}
+
+// Test the daily problem only depends on the date in UTC and the level.
+func TestGenerateDailySudokuProblem(t *testing.T) {
+	store := newTestSolverStore(t)
+	options := NewSudokuProblemOptions(store, NewEasySudokuDifficulty())
+	date := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	problem := GenerateDailySudokuProblem(options, date)
+	if other := GenerateDailySudokuProblem(options, date); other.ToString() != problem.ToString() {
+		t.Errorf("Expected the same problem on the same date, got %s and %s", problem.ToString(), other.ToString())
+	}
+
+	// The same instant in another location is the same date in UTC.
+	if other := GenerateDailySudokuProblem(options, date.In(time.FixedZone("UTC+10", 10*60*60))); other.ToString() != problem.ToString() {
+		t.Errorf("Expected the same problem in another location, got %s and %s", problem.ToString(), other.ToString())
+	}
+
+	if other := GenerateDailySudokuProblem(options, date.AddDate(0, 0, 1)); other.ToString() == problem.ToString() {
+		t.Errorf("Expected another problem on another date, got %s", problem.ToString())
+	}
+
+	if GetDailySeed(date, NewEasySudokuDifficulty()) == GetDailySeed(date, NewHardSudokuDifficulty()) {
+		t.Error("Expected another seed for another level")
+	}
+}
+
+// Test a problem using the target technique is closer than one without it, whatever their scores.
+func TestGetRatingDistance(t *testing.T) {
+	difficulty := NewTechniqueSudokuDifficulty("wings")
+	unused := getRatingDistance(solver.SudokuRating{Score: 4.2, Solved: true}, difficulty, 4.2, 4.2)
+	used := getRatingDistance(solver.SudokuRating{Score: 5.3, Solved: true, TechniqueCounts: map[string]int{"wings": 1}}, difficulty, 4.2, 4.2)
+
+	if !used.isCloserThan(unused) || unused.isCloserThan(used) || used.isZero() || unused.isZero() {
+		t.Errorf("Expected the problem using the technique to be closer, got %+v and %+v", used, unused)
+	}
+}
+
+// Test the closest problem is returned when no attempt meets the difficulty.
+func TestGenerateClosestSudokuProblem(t *testing.T) {
+	store := newTestSolverStore(t)
+	rater := solver.NewSudokuRater(store)
+	difficulty := NewTechniqueSudokuDifficulty("swordfish")
+	minimumScore, maximumScore := getTargetScoreRange(difficulty, rater)
+
+	// With the same seed, the first attempt is the same, so more attempts can only give a closer problem.
+	distances := []problemDistance{}
+	for _, attempts := range []int{1, 3} {
+		options := NewSudokuProblemOptions(store, difficulty)
+		options.Random = util.NewSeededRandomSource(1)
+		options.MaximumAttempts = attempts
+
+		problem := GenerateSudokuProblem(options)
+		rating := rater.Rate(&problem)
+		if !rating.Solved {
+			t.Fatalf("Expected a problem solved by the rater with %d attempts, got %+v", attempts, rating)
+		}
+
+		distances = append(distances, getRatingDistance(rating, difficulty, minimumScore, maximumScore))
+	}
+
+	if distances[0].isZero() {
+		t.Fatalf("Expected the first attempt to miss the target technique, got %+v", distances[0])
+	}
+
+	if distances[0].isCloserThan(distances[1]) {
+		t.Errorf("Expected the closest problem of the attempts, got %+v after one attempt and %+v after three", distances[0], distances[1])
+	}
+}
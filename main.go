@@ -1,19 +1,26 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"time"
 
 	"github.com/gnailuy/sudoku/cli"
 	"github.com/gnailuy/sudoku/core"
 	"github.com/gnailuy/sudoku/game"
 	"github.com/gnailuy/sudoku/generator"
 	"github.com/gnailuy/sudoku/solver"
+	"github.com/gnailuy/sudoku/util"
 )
 
 func main() {
 	// Create and initialize the solver store.
 	solverStore := solver.NewSudokuSolverStore()
+	if err := solverStore.SetDefaultSolver("dlx"); err != nil {
+		panic("Bug: Invalid default solver: " + err.Error())
+	}
 
 	// Parse the command line options.
 	options := cli.NewCommandLineOptions()
@@ -24,6 +31,10 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Abort the long running tasks on interrupt.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
 	if *options.Input != "" {
 		// Read the input as a Sudoku string
 		problem, err := generator.GenerateSudokuProblemFromString(*options.Input)
@@ -33,26 +44,74 @@ func main() {
 			os.Exit(1)
 		}
 
-		solutionCount := solverStore.GetDefaultSolver().CountSolutions(problem)
-		if solutionCount == 0 {
+		// Only count up to two solutions, enough to know if the solution is unique.
+		countCtx, cancel := withTimeout(ctx, *options.Timeout)
+		solutionCount, err := solverStore.GetDefaultSolver().CountSolutionsUpToContext(countCtx, problem, 2)
+		cancel()
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to count the solutions of the input: %s\n", err)
+			os.Exit(1)
+		} else if solutionCount == 0 {
 			fmt.Fprintf(os.Stderr, "The input is not a solvable Sudoku problem: %s\n", *options.Input)
 			os.Exit(1)
 		} else if solutionCount > 1 {
-			fmt.Fprintf(os.Stderr, "The input has %d solutions: %s\n", solutionCount, *options.Input)
+			fmt.Fprintf(os.Stderr, "The input has multiple solutions: %s\n", *options.Input)
 		}
 
-		playCli(*problem, solverStore)
+		playCli(ctx, *problem, solverStore)
+	} else if options.IsDaily() {
+		// Generate the problem of the day.
+		date, err := options.GetDailyDate()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to get the date of the daily problem: %s\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Generating the %s Sudoku problem of %s...\n", options.Level.String(), date.Format(time.DateOnly))
+
+		generateCtx, cancel := withTimeout(ctx, *options.Timeout)
+		problem, err := generator.GenerateDailySudokuProblemContext(generateCtx, generator.NewSudokuProblemOptions(solverStore, options.GetDifficultyOptions()), date)
+		cancel()
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to generate the daily Sudoku problem: %s\n", err)
+			os.Exit(1)
+		}
+
+		playCli(ctx, problem, solverStore)
 	} else {
-		// Generate a random problem.
-		fmt.Printf("Generating a random %s Sudoku problem...\n", options.Level.String())
-		problem := generator.GenerateSudokuProblem(generator.NewSudokuProblemOptions(solverStore, options.GetDifficultyOptions()))
+		// Generate a random problem, reproducible with the seed.
+		seed := options.GetSeed()
+		fmt.Printf("Generating a random %s Sudoku problem with seed %d...\n", options.Level.String(), seed)
+
+		generatorOptions := generator.NewSudokuProblemOptions(solverStore, options.GetDifficultyOptions())
+		generatorOptions.Random = util.NewSeededRandomSource(seed)
+
+		generateCtx, cancel := withTimeout(ctx, *options.Timeout)
+		problem, err := generator.GenerateSudokuProblemContext(generateCtx, generatorOptions)
+		cancel()
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to generate a Sudoku problem: %s\n", err)
+			os.Exit(1)
+		}
+
+		playCli(ctx, problem, solverStore)
+	}
+}
 
-		playCli(problem, solverStore)
+// Function to get a context limited by the timeout, or only cancellable if the timeout is not positive.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
 	}
+
+	return context.WithTimeout(ctx, timeout)
 }
 
 // Function to play a game in CLI.
-func playCli(problem core.SudokuBoard, solverStore solver.SudokuSolverStore) {
+func playCli(ctx context.Context, problem core.SudokuBoard, solverStore solver.SudokuSolverStore) {
 	newGame := game.NewSudokuGame(problem, game.NewDefaultSudokuGameOptions(solverStore))
-	newGame.PlayCli()
+	newGame.PlayCli(ctx)
 }
@@ -1,6 +1,11 @@
 package solver
 
-import "github.com/gnailuy/sudoku/core"
+import (
+	"context"
+
+	"github.com/gnailuy/sudoku/core"
+	"github.com/gnailuy/sudoku/util"
+)
 
 // Define the interface of a Sudoku solver.
 type ISudokuSolver interface {
@@ -19,11 +24,28 @@ type ISudokuSolver interface {
 	// Solve the Sudoku board, return false if the solver cannot fully solve the board.
 	Solve(board *core.SudokuBoard) bool
 
+	// Solve the Sudoku board like Solve, but stop with the error of the context when it is done. The board is left untouched on error.
+	SolveContext(ctx context.Context, board *core.SudokuBoard) (bool, error)
+
 	// Give a hint for the next step of the board, return nil if the solver cannot give a hint.
 	Hint(board *core.SudokuBoard) *core.Cell
 
 	// Count the number of solutions of the board. Return 0 if the solver cannot solve the board; return 1 if the board is already solved.
 	CountSolutions(board *core.SudokuBoard) int
+
+	// Count the number of solutions of the board like CountSolutions, but stop as soon as the limit is reached. A limit of 0 means no limit.
+	CountSolutionsUpTo(board *core.SudokuBoard, limit int) int
+
+	// Count the number of solutions of the board like CountSolutionsUpTo, but stop with the error of the context when it is done.
+	CountSolutionsUpToContext(ctx context.Context, board *core.SudokuBoard, limit int) (int, error)
+}
+
+// Define the interface of a solver making random choices, such as the order of the candidates to try.
+type IRandomizedSolver interface {
+	ISudokuSolver
+
+	// Return a copy of the solver making its random choices with the source, so that the same seed gives the same results.
+	WithRandomSource(source util.IRandomSource) ISudokuSolver
 }
 
 // Define the base solver embedding the key and other properties.
@@ -64,3 +86,23 @@ func (solver BaseSolver) CountSolutions(board *core.SudokuBoard) int {
 	// Unreliable solvers should return 0 as they may not be able to fully solve the board.
 	return 0
 }
+
+// Function to implement the default bounded solution counting logic on the base solver.
+func (solver BaseSolver) CountSolutionsUpTo(board *core.SudokuBoard, limit int) int {
+	// Reliable solvers should always override this function.
+	if solver.Reliable {
+		panic("Bug: Reliable solver should override the CountSolutionsUpTo function")
+	}
+
+	return 0
+}
+
+// Function to implement the default bounded solution counting logic with a context on the base solver.
+func (solver BaseSolver) CountSolutionsUpToContext(ctx context.Context, board *core.SudokuBoard, limit int) (int, error) {
+	// Reliable solvers should always override this function.
+	if solver.Reliable {
+		panic("Bug: Reliable solver should override the CountSolutionsUpToContext function")
+	}
+
+	return 0, ctx.Err()
+}
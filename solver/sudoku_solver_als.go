@@ -0,0 +1,347 @@
+package solver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the almost locked sets solver object.
+type AlmostLockedSetsSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default AlmostLockedSetsSolver object.
+func NewAlmostLockedSetsSolver() AlmostLockedSetsSolver {
+	return AlmostLockedSetsSolver{
+		newStrategySolver(BaseSolver{
+			Key:         "als",
+			DisplayName: "Almost Locked Sets Solver",
+			Description: `Strategy solver using singles and almost locked sets (N cells of a house with N+1 candidates): ALS-XZ, ALS-XY-Wing and Death Blossom.`,
+			Reliable:    false,
+		}, findAlmostLockedSetsStep),
+	}
+}
+
+// Define the rules based on almost locked sets.
+type AlmostLockedSetsRule int
+
+const (
+	AlsXZ        AlmostLockedSetsRule = iota // Two sets linked by a restricted common value.
+	AlsXYWing                                // Two sets linked to a third one by two different restricted common values.
+	DeathBlossom                             // A stem cell whose every candidate is restricted common with a set.
+)
+
+// Names of the rules based on almost locked sets.
+var almostLockedSetsRuleNames = map[AlmostLockedSetsRule]string{
+	AlsXZ:        "ALS-XZ",
+	AlsXYWing:    "ALS-XY-Wing",
+	DeathBlossom: "Death Blossom",
+}
+
+// Define an almost locked set: N empty cells of a house with N+1 candidates in total.
+type AlmostLockedSet struct {
+	House     core.House        // The house of the set.
+	Positions []core.Position   // The cells of the set.
+	Values    core.CandidateSet // The candidates of the set.
+}
+
+// Function to print the almost locked set.
+func (set AlmostLockedSet) ToString() string {
+	return fmt.Sprintf("%s at %s in %s", set.Values.ToString(), formatPositions(set.Positions), set.House.ToString())
+}
+
+// Function to get the positions of the set holding the value as a candidate.
+func (set AlmostLockedSet) getPositionsWithCandidate(grid *core.CandidateGrid, value int) []core.Position {
+	positions := []core.Position{}
+	for _, position := range set.Positions {
+		if grid.HasCandidate(position, value) {
+			positions = append(positions, position)
+		}
+	}
+
+	return positions
+}
+
+// Define the pattern of an almost locked sets step.
+type AlmostLockedSetsPattern struct {
+	Rule       AlmostLockedSetsRule // The rule applied.
+	Value      int                  // The value removed from the cells seeing all its candidates in the sets.
+	Sets       []AlmostLockedSet    // The sets of the pattern, the pivot set of an ALS-XY-Wing is the last one.
+	Restricted []int                // The restricted common values linking the sets, or the candidates of the stem.
+	Stem       *core.Position       // The stem cell of a Death Blossom, nil otherwise.
+}
+
+// Function to print the almost locked sets pattern.
+func (pattern AlmostLockedSetsPattern) ToString() string {
+	sets := make([]string, len(pattern.Sets))
+	for i, set := range pattern.Sets {
+		sets[i] = set.ToString()
+	}
+
+	result := fmt.Sprintf("%s on %d with %s", almostLockedSetsRuleNames[pattern.Rule], pattern.Value, strings.Join(sets, " and "))
+	if pattern.Stem != nil {
+		result += " from the stem " + pattern.Stem.ToString()
+	}
+
+	return result + fmt.Sprintf(", restricted common %v", pattern.Restricted)
+}
+
+// Function to find all the almost locked sets of the grid, each set of cells only once.
+func findAlmostLockedSets(grid *core.CandidateGrid) []AlmostLockedSet {
+	sets := []AlmostLockedSet{}
+	seen := map[string]bool{}
+	for _, house := range core.AllHouses() {
+		positions := grid.GetEmptyPositions(house)
+		for size := 1; size < len(positions); size++ {
+			for _, subset := range combinations(positions, size) {
+				values := core.CandidateSet(0)
+				for _, position := range subset {
+					values = values.Union(grid.GetCandidates(position))
+				}
+
+				if values.Count() != size+1 {
+					continue
+				}
+
+				key := formatPositions(subset)
+				if seen[key] {
+					continue
+				}
+
+				seen[key] = true
+				sets = append(sets, AlmostLockedSet{House: house, Positions: subset, Values: values})
+			}
+		}
+	}
+
+	return sets
+}
+
+// Function to check if two sets share a cell.
+func setsOverlap(first AlmostLockedSet, second AlmostLockedSet) bool {
+	for _, position := range first.Positions {
+		if containsPosition(second.Positions, position) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to check if every position of the first list sees every position of the second list.
+func seesAll(first []core.Position, second []core.Position) bool {
+	for _, position := range first {
+		for _, other := range second {
+			if !position.IsPeerOf(other) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Function to find the restricted common values of two disjoint sets:
+// the values of both sets whose candidates in one set all see its candidates in the other.
+// At most one of the sets can then hold such a value.
+func findRestrictedCommonValues(grid *core.CandidateGrid, first AlmostLockedSet, second AlmostLockedSet) []int {
+	restricted := []int{}
+	for _, value := range first.Values.Intersect(second.Values).Digits() {
+		if seesAll(first.getPositionsWithCandidate(grid, value), second.getPositionsWithCandidate(grid, value)) {
+			restricted = append(restricted, value)
+		}
+	}
+
+	return restricted
+}
+
+// Function to collect the eliminations of the value from the cells outside the sets seeing all its candidates in the sets.
+func collectSetsEliminations(grid *core.CandidateGrid, value int, sets ...AlmostLockedSet) []core.Cell {
+	positions := []core.Position{}
+	for _, set := range sets {
+		positions = append(positions, set.getPositionsWithCandidate(grid, value)...)
+	}
+
+	if len(positions) == 0 {
+		return nil
+	}
+
+	return collectEliminations(grid, getCommonPeers(grid, positions...), value)
+}
+
+// Define a link between two sets by a restricted common value.
+type setLink struct {
+	other int
+	value int
+}
+
+// Function to link every pair of disjoint sets by their restricted common values.
+func linkAlmostLockedSets(grid *core.CandidateGrid, sets []AlmostLockedSet) [][]setLink {
+	links := make([][]setLink, len(sets))
+	for i := range sets {
+		for j := i + 1; j < len(sets); j++ {
+			if sets[i].Values.Intersect(sets[j].Values).Count() == 0 || setsOverlap(sets[i], sets[j]) {
+				continue
+			}
+
+			for _, value := range findRestrictedCommonValues(grid, sets[i], sets[j]) {
+				links[i] = append(links[i], setLink{other: j, value: value})
+				links[j] = append(links[j], setLink{other: i, value: value})
+			}
+		}
+	}
+
+	return links
+}
+
+// Function to find an ALS-XZ: sets A and B with a restricted common value x. One of them is locked without x,
+// so a common value z is true in A or in B and can be removed from the cells seeing all its candidates in both.
+func findAlsXZ(grid *core.CandidateGrid, sets []AlmostLockedSet, links [][]setLink) *SolveStep {
+	for i, setLinks := range links {
+		for _, link := range setLinks {
+			if link.other < i {
+				continue
+			}
+
+			first, second := sets[i], sets[link.other]
+			for _, value := range first.Values.Intersect(second.Values).Remove(link.value).Digits() {
+				eliminations := collectSetsEliminations(grid, value, first, second)
+				if len(eliminations) > 0 {
+					return &SolveStep{
+						Eliminations: eliminations,
+						Pattern: AlmostLockedSetsPattern{
+							Rule: AlsXZ, Value: value, Sets: []AlmostLockedSet{first, second}, Restricted: []int{link.value},
+						},
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find an ALS-XY-Wing: sets A and B linked to a pivot set C by different restricted common values x and y.
+// The pivot cannot hold both, so A or B is locked, and a common value z of A and B is removed from the cells seeing all of it.
+func findAlsXYWing(grid *core.CandidateGrid, sets []AlmostLockedSet, links [][]setLink) *SolveStep {
+	for pivot, pivotLinks := range links {
+		for _, pair := range combinations(pivotLinks, 2) {
+			first, second := sets[pair[0].other], sets[pair[1].other]
+			if pair[0].other == pair[1].other || pair[0].value == pair[1].value || setsOverlap(first, second) {
+				continue
+			}
+
+			common := first.Values.Intersect(second.Values).Remove(pair[0].value).Remove(pair[1].value)
+			for _, value := range common.Digits() {
+				eliminations := collectSetsEliminations(grid, value, first, second)
+				if len(eliminations) > 0 {
+					return &SolveStep{
+						Eliminations: eliminations,
+						Pattern: AlmostLockedSetsPattern{
+							Rule: AlsXYWing, Value: value, Sets: []AlmostLockedSet{first, second, sets[pivot]}, Restricted: []int{pair[0].value, pair[1].value},
+						},
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find a Death Blossom: every candidate d of a stem cell has a petal set holding d,
+// whose candidates d all see the stem. Whatever the stem holds, one petal is locked, so a value z
+// common to all the petals is removed from the cells seeing all its candidates in the petals.
+func findDeathBlossom(grid *core.CandidateGrid, sets []AlmostLockedSet) *SolveStep {
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			stem := core.NewPosition(row, column)
+			stemValues := grid.GetCandidates(stem)
+			if stemValues.Count() < 2 || stemValues.Count() > 3 {
+				continue
+			}
+
+			// Collect the petal candidates of every value of the stem.
+			petals := [][]AlmostLockedSet{}
+			for _, value := range stemValues.Digits() {
+				valuePetals := []AlmostLockedSet{}
+				for _, set := range sets {
+					if set.Values.Contains(value) && !containsPosition(set.Positions, stem) &&
+						seesAll(set.getPositionsWithCandidate(grid, value), []core.Position{stem}) {
+						valuePetals = append(valuePetals, set)
+					}
+				}
+				petals = append(petals, valuePetals)
+			}
+
+			if step := findDeathBlossomPetals(grid, stem, petals, []AlmostLockedSet{}, core.AllCandidates.Difference(stemValues)); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to choose one disjoint petal for each value of the stem, keeping the values common to the chosen petals.
+func findDeathBlossomPetals(grid *core.CandidateGrid, stem core.Position, petals [][]AlmostLockedSet, chosen []AlmostLockedSet, common core.CandidateSet) *SolveStep {
+	if len(chosen) == len(petals) {
+		for _, value := range common.Digits() {
+			eliminations := collectSetsEliminations(grid, value, chosen...)
+			if len(eliminations) > 0 {
+				return &SolveStep{
+					Eliminations: eliminations,
+					Pattern: AlmostLockedSetsPattern{
+						Rule: DeathBlossom, Value: value, Sets: chosen, Restricted: grid.GetCandidates(stem).Digits(), Stem: &stem,
+					},
+				}
+			}
+		}
+
+		return nil
+	}
+
+	for _, petal := range petals[len(chosen)] {
+		nextCommon := common.Intersect(petal.Values)
+		if nextCommon.IsEmpty() {
+			continue
+		}
+
+		disjoint := true
+		for _, other := range chosen {
+			if setsOverlap(petal, other) {
+				disjoint = false
+				break
+			}
+		}
+
+		if !disjoint {
+			continue
+		}
+
+		next := append(append([]AlmostLockedSet{}, chosen...), petal)
+		if step := findDeathBlossomPetals(grid, stem, petals, next, nextCommon); step != nil {
+			return step
+		}
+	}
+
+	return nil
+}
+
+// Function to find the next almost locked sets step, from the simplest rule.
+func findAlmostLockedSetsStep(grid *core.CandidateGrid) *SolveStep {
+	sets := findAlmostLockedSets(grid)
+	links := linkAlmostLockedSets(grid, sets)
+
+	if step := findAlsXZ(grid, sets, links); step != nil {
+		return step
+	}
+
+	if step := findAlsXYWing(grid, sets, links); step != nil {
+		return step
+	}
+
+	return findDeathBlossom(grid, sets)
+}
@@ -0,0 +1,340 @@
+package solver
+
+import (
+	"context"
+	"math/bits"
+
+	"github.com/gnailuy/sudoku/core"
+	"github.com/gnailuy/sudoku/util"
+)
+
+// Define the bitmask solver object.
+type BitmaskSolver struct {
+	BaseSolver
+	random util.IRandomSource // The source of the random choices.
+}
+
+// Constructor like function to create a default BitmaskSolver object.
+func NewBitmaskSolver() BitmaskSolver {
+	return BitmaskSolver{
+		BaseSolver: BaseSolver{
+			Key:         "bitmask",
+			DisplayName: "Bitmask Solver",
+			Description: `Backtracking solver on row, column and box bitmasks, propagating naked and hidden singles and branching on the cell with the fewest candidates.`,
+			Reliable:    true,
+		},
+		random: util.GlobalRandomSource,
+	}
+}
+
+// The mask of all the values, bit v stands for the value v.
+const bitmaskAllValues uint16 = 0x3FE
+
+// The 27 houses of the board as cell indexes, row * 9 + column.
+var bitmaskHouses = func() (houses [27][9]int) {
+	for i := 0; i < 9; i++ {
+		for j := 0; j < 9; j++ {
+			houses[i][j] = i*9 + j
+			houses[9+i][j] = j*9 + i
+			houses[18+i][j] = (i/3*3+j/3)*9 + i%3*3 + j%3
+		}
+	}
+
+	return houses
+}()
+
+// Define the state of a bitmask search, small enough to be copied at every branch.
+type bitmaskBoard struct {
+	cells   [81]uint8 // The value of each cell, 0 if empty.
+	rows    [9]uint16 // The values placed in each row.
+	columns [9]uint16 // The values placed in each column.
+	boxes   [9]uint16 // The values placed in each box.
+}
+
+// Constructor like function to create the bitmask board of a board.
+// Return false if the filled cells conflict with each other.
+func newBitmaskBoard(board *core.SudokuBoard) (state bitmaskBoard, ok bool) {
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			value := board.Get(core.NewPosition(row, column))
+			if value == 0 {
+				continue
+			}
+
+			if state.getCandidates(row*9+column)&(1<<value) == 0 {
+				return state, false
+			}
+
+			state.place(row*9+column, value)
+		}
+	}
+
+	return state, true
+}
+
+// Function to get the candidates mask of a cell from the values placed in its houses.
+func (state *bitmaskBoard) getCandidates(cell int) uint16 {
+	row, column := cell/9, cell%9
+	return bitmaskAllValues &^ (state.rows[row] | state.columns[column] | state.boxes[row/3*3+column/3])
+}
+
+// Function to place a value in a cell.
+func (state *bitmaskBoard) place(cell int, value int) {
+	row, column := cell/9, cell%9
+	mask := uint16(1) << value
+
+	state.cells[cell] = uint8(value)
+	state.rows[row] |= mask
+	state.columns[column] |= mask
+	state.boxes[row/3*3+column/3] |= mask
+}
+
+// Function to place the naked and hidden singles until there is none left, return the number of values placed.
+// Return false if a contradiction is found: an empty cell without candidates, or a value without place in a house.
+func (state *bitmaskBoard) propagate() (placed int, ok bool) {
+	for changed := true; changed; {
+		changed = false
+
+		// Naked singles: an empty cell with only one candidate.
+		for cell := 0; cell < 81; cell++ {
+			if state.cells[cell] != 0 {
+				continue
+			}
+
+			candidates := state.getCandidates(cell)
+			if candidates == 0 {
+				return placed, false
+			}
+
+			if bits.OnesCount16(candidates) == 1 {
+				state.place(cell, bits.TrailingZeros16(candidates))
+				placed++
+				changed = true
+			}
+		}
+
+		// Hidden singles: a value with only one place in a house.
+		for _, house := range bitmaskHouses {
+			values, once, twice := uint16(0), uint16(0), uint16(0)
+			for _, cell := range house {
+				if state.cells[cell] != 0 {
+					values |= 1 << state.cells[cell]
+					continue
+				}
+
+				candidates := state.getCandidates(cell)
+				twice |= once & candidates
+				once |= candidates
+			}
+
+			if values|once != bitmaskAllValues {
+				return placed, false
+			}
+
+			hidden := once &^ twice
+			if hidden == 0 {
+				continue
+			}
+
+			for _, cell := range house {
+				if state.cells[cell] == 0 {
+					if candidates := state.getCandidates(cell) & hidden; candidates != 0 {
+						// A cell holding two hidden singles of the house is a contradiction, caught by the next round.
+						state.place(cell, bits.TrailingZeros16(candidates))
+						placed++
+						changed = true
+					}
+				}
+			}
+		}
+	}
+
+	return placed, true
+}
+
+// Define the options and results of a bitmask search.
+type bitmaskSearch struct {
+	contextCheck
+	statsRecorder
+	random            util.IRandomSource // The source to try the candidates of a cell in a random order, nil to keep the order.
+	limit             int                // Stop after finding this number of solutions, 0 means no limit.
+	numberOfSolutions int                // The number of solutions found.
+	firstSolution     *bitmaskBoard      // The first solution found.
+}
+
+// Function to search the solutions from the state at the depth of guesses, return true if the search should stop.
+func (search *bitmaskSearch) search(state bitmaskBoard, depth int) bool {
+	search.visit(depth)
+	if search.isDone() {
+		return true
+	}
+
+	placed, ok := state.propagate()
+	search.stats.Propagations += placed
+	if !ok {
+		return false
+	}
+
+	// Branch on the empty cell with the fewest candidates.
+	best, bestCount := -1, 10
+	for cell := 0; cell < 81 && bestCount > 2; cell++ {
+		if state.cells[cell] == 0 {
+			if count := bits.OnesCount16(state.getCandidates(cell)); count < bestCount {
+				best, bestCount = cell, count
+			}
+		}
+	}
+
+	// All the cells are filled, a solution is found.
+	if best < 0 {
+		search.numberOfSolutions++
+		if search.firstSolution == nil {
+			search.firstSolution = &state
+		}
+
+		return search.limit > 0 && search.numberOfSolutions >= search.limit
+	}
+
+	values := []int{}
+	for candidates := state.getCandidates(best); candidates != 0; candidates &= candidates - 1 {
+		values = append(values, bits.TrailingZeros16(candidates))
+	}
+
+	if search.random != nil {
+		util.ShuffleArrayWith(search.random, values)
+	}
+
+	for _, value := range values {
+		next := state
+		next.place(best, value)
+
+		if search.search(next, depth+1) {
+			return true
+		}
+
+		search.stats.Backtracks++
+	}
+
+	return false
+}
+
+// Function to search the board until the context is done, return nil if the filled cells conflict.
+func searchBitmask(ctx context.Context, board *core.SudokuBoard, random util.IRandomSource, limit int) *bitmaskSearch {
+	state, ok := newBitmaskBoard(board)
+	if !ok {
+		return nil
+	}
+
+	search := &bitmaskSearch{contextCheck: newContextCheck(ctx), statsRecorder: newStatsRecorder(), random: random, limit: limit}
+	search.search(state, 0)
+
+	return search
+}
+
+// Function to get a copy of the solver making its random choices with the source.
+func (solver BitmaskSolver) WithRandomSource(source util.IRandomSource) ISudokuSolver {
+	solver.random = source
+	return solver
+}
+
+// Function to solve the Sudoku board, picking a random solution if there are several.
+func (solver BitmaskSolver) Solve(board *core.SudokuBoard) bool {
+	solved, _ := solver.SolveContext(context.Background(), board)
+	return solved
+}
+
+// Function to solve the Sudoku board, picking a random solution if there are several, stopping when the context is done.
+func (solver BitmaskSolver) SolveContext(ctx context.Context, board *core.SudokuBoard) (bool, error) {
+	return solver.SolveWithStats(ctx, board, nil)
+}
+
+// Function to solve the Sudoku board like SolveContext, adding the statistics of the search to the stats if not nil.
+func (solver BitmaskSolver) SolveWithStats(ctx context.Context, board *core.SudokuBoard, stats *SolveStats) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	if !board.IsValid() {
+		return false, nil
+	}
+
+	search := searchBitmask(ctx, board, solver.random, 1)
+	if search == nil {
+		return false, nil
+	}
+
+	search.report(stats)
+
+	if search.firstSolution == nil {
+		return false, search.err
+	}
+
+	for cell, value := range search.firstSolution.cells {
+		board.Set(core.NewPosition(cell/9, cell%9), int(value))
+	}
+
+	return true, nil
+}
+
+// Function to give the value of a random empty cell in a solution as a hint, without solving the board.
+func (solver BitmaskSolver) Hint(board *core.SudokuBoard) *core.Cell {
+	if !board.IsValid() {
+		return nil
+	}
+
+	search := searchBitmask(context.Background(), board, solver.random, 1)
+	if search == nil || search.firstSolution == nil {
+		return nil
+	}
+
+	hints := []core.Cell{}
+	for cell, value := range search.firstSolution.cells {
+		position := core.NewPosition(cell/9, cell%9)
+		if board.Get(position) == 0 {
+			hints = append(hints, core.NewCell(position, int(value)))
+		}
+	}
+
+	if len(hints) == 0 {
+		return nil
+	}
+
+	return &hints[util.RandomIntWith(solver.random, 0, len(hints))]
+}
+
+// Function to count the number of solutions for the Sudoku board.
+// Note that if the board is already solved, we return 1 as doing nothing is also a solution.
+func (solver BitmaskSolver) CountSolutions(board *core.SudokuBoard) int {
+	return solver.CountSolutionsUpTo(board, 0)
+}
+
+// Function to count the number of solutions for the Sudoku board, stopping at the limit.
+func (solver BitmaskSolver) CountSolutionsUpTo(board *core.SudokuBoard, limit int) int {
+	count, _ := solver.CountSolutionsUpToContext(context.Background(), board, limit)
+	return count
+}
+
+// Function to count the number of solutions for the Sudoku board, stopping at the limit or when the context is done.
+func (solver BitmaskSolver) CountSolutionsUpToContext(ctx context.Context, board *core.SudokuBoard, limit int) (int, error) {
+	return solver.CountSolutionsWithStats(ctx, board, limit, nil)
+}
+
+// Function to count the number of solutions for the Sudoku board up to the limit, adding the statistics of the search to the stats if not nil.
+func (solver BitmaskSolver) CountSolutionsWithStats(ctx context.Context, board *core.SudokuBoard, limit int, stats *SolveStats) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if !board.IsValid() {
+		return 0, nil
+	}
+
+	search := searchBitmask(ctx, board, nil, limit)
+	if search == nil {
+		return 0, nil
+	}
+
+	search.report(stats)
+
+	return search.numberOfSolutions, search.err
+}
@@ -0,0 +1,118 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Boards of increasing difficulty used to compare the reliable solvers.
+// Harder boards take the default solver minutes, so they are only used in the tests.
+var benchmarkBoards = []string{
+	"583.67..46723.48...4.8253.6934..852.2.74519.3851.3.4673..589742.952461.84.87..659",
+	".....58...7..2.91.9.....64....93.5..42...1..8..8.....1.....4...1.68...5.28...3...",
+	".....2..4..6.5...31....98.5.....627.....7..4..93.....68...41.....2.......61.9..3.",
+}
+
+// Test the bitmask solver gives the same results as the default solver.
+func TestBitmaskSolver(t *testing.T) {
+	solver := NewBitmaskSolver()
+	if !solver.IsReliable() {
+		t.Error("Expected the bitmask solver to be reliable")
+	}
+
+	hardBoards := []string{
+		"48.3............71.2.......7.5....6....2..8.............1.76...3.....4......5....",
+		"4.....8.5.3..........7......2.....6.....8.4......1.......6.3.7.5..2.....1.4......",
+	}
+
+	for _, input := range append(hardBoards, benchmarkBoards...) {
+		board := newBoardFromString(input)
+		if count := solver.CountSolutions(&board); count != 1 {
+			t.Errorf("Expected 1 solution for %s, got %d", input, count)
+		}
+
+		expected := board.Copy()
+		NewDancingLinksSolver().Solve(&expected)
+
+		hint := solver.Hint(&board)
+		if hint == nil || board.Get(hint.Position) != 0 || expected.Get(hint.Position) != hint.Value {
+			t.Errorf("Unexpected hint for %s: %v", input, hint)
+		}
+
+		if !solver.Solve(&board) || board.ToString() != expected.ToString() {
+			t.Errorf("Unexpected solution for %s: %s", input, board.ToString())
+		}
+	}
+
+	// Removing all the 1s and 2s of a solved board gives several solutions, as they can be swapped.
+	board := newBoardFromString("583167294672394815149825376934678521267451983851932467316589742795246138428713659")
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			if position := core.NewPosition(row, column); board.Get(position) <= 2 {
+				board.Unset(position)
+			}
+		}
+	}
+
+	if count, expected := solver.CountSolutions(&board), NewDefaultSolver().CountSolutions(&board); count != expected || count < 2 {
+		t.Errorf("Expected %d solutions, got %d", expected, count)
+	}
+
+	// Conflicting cells have no solution.
+	board = newBoardFromString("55" + benchmarkBoards[1][2:])
+	if solver.CountSolutions(&board) != 0 || solver.Solve(&board) {
+		t.Error("Expected no solution for a conflicting board")
+	}
+}
+
+// Function to run a solve benchmark of a solver on the benchmark boards.
+func benchmarkSolve(b *testing.B, solver ISudokuSolver) {
+	boards := make([]core.SudokuBoard, len(benchmarkBoards))
+	for i, input := range benchmarkBoards {
+		boards[i] = newBoardFromString(input)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		board := boards[i%len(boards)].Copy()
+		solver.Solve(&board)
+	}
+}
+
+// Function to run a solution counting benchmark of a solver on the benchmark boards.
+func benchmarkCountSolutions(b *testing.B, solver ISudokuSolver) {
+	boards := make([]core.SudokuBoard, len(benchmarkBoards))
+	for i, input := range benchmarkBoards {
+		boards[i] = newBoardFromString(input)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		solver.CountSolutions(&boards[i%len(boards)])
+	}
+}
+
+func BenchmarkDefaultSolverSolve(b *testing.B) {
+	benchmarkSolve(b, NewDefaultSolver())
+}
+
+func BenchmarkBitmaskSolverSolve(b *testing.B) {
+	benchmarkSolve(b, NewBitmaskSolver())
+}
+
+func BenchmarkDancingLinksSolverSolve(b *testing.B) {
+	benchmarkSolve(b, NewDancingLinksSolver())
+}
+
+func BenchmarkDefaultSolverCountSolutions(b *testing.B) {
+	benchmarkCountSolutions(b, NewDefaultSolver())
+}
+
+func BenchmarkBitmaskSolverCountSolutions(b *testing.B) {
+	benchmarkCountSolutions(b, NewBitmaskSolver())
+}
+
+func BenchmarkDancingLinksSolverCountSolutions(b *testing.B) {
+	benchmarkCountSolutions(b, NewDancingLinksSolver())
+}
@@ -0,0 +1,393 @@
+package solver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the kinds of chains.
+type ChainKind int
+
+const (
+	XChain               ChainKind = iota // Chains on a single value with single cell nodes.
+	XYChain                               // Chains through bivalue cells only.
+	AlternatingInference                  // General alternating inference chains, with grouped nodes.
+)
+
+// Names and keys of the chain kinds.
+var chainKindNames = map[ChainKind]string{XChain: "X-Chain", XYChain: "XY-Chain", AlternatingInference: "AIC"}
+var chainKindKeys = map[ChainKind]string{XChain: "x-chain", XYChain: "xy-chain", AlternatingInference: "aic"}
+
+// The default maximum number of nodes in a chain.
+const DefaultMaximumChainLength = 12
+
+// Define the chain solver object.
+type ChainSolver struct {
+	StrategySolver
+	Kind          ChainKind // The kind of chains to search for.
+	MaximumLength int       // The maximum number of nodes in a chain.
+}
+
+// Constructor like function to create a ChainSolver object searching chains of the kind up to the maximum length.
+func NewChainSolver(kind ChainKind, maximumLength int) ChainSolver {
+	if maximumLength < 4 {
+		panic("Bug: The maximum chain length must be at least 4, got " + fmt.Sprint(maximumLength))
+	}
+
+	return ChainSolver{
+		StrategySolver: newStrategySolver(BaseSolver{
+			Key:         chainKindKeys[kind],
+			DisplayName: chainKindNames[kind] + " Solver",
+			Description: fmt.Sprintf(`Strategy solver using singles and the %s with up to %d nodes: either end of a chain of alternating strong and weak links is true.`, chainKindNames[kind], maximumLength),
+			Reliable:    false,
+		}, func(grid *core.CandidateGrid) *SolveStep {
+			return findChainStep(grid, kind, maximumLength)
+		}),
+		Kind:          kind,
+		MaximumLength: maximumLength,
+	}
+}
+
+// Define the type of the link between two chain nodes.
+type ChainLink int
+
+const (
+	ChainLinkStrong ChainLink = iota // At least one of the two nodes is true.
+	ChainLinkWeak                    // At most one of the two nodes is true.
+)
+
+// Define a node of a chain: a value in one cell, or in a group of cells sharing a box and a line.
+type ChainNode struct {
+	Positions []core.Position // The positions of the node, more than one for a grouped node.
+	Value     int             // The value of the node.
+	Link      ChainLink       // The link to the next node, ignored on the last node.
+}
+
+// Function to print the chain node, e.g. (1, 2)(1, 3)[4].
+func (node ChainNode) ToString() string {
+	result := ""
+	for _, position := range node.Positions {
+		result += position.ToString()
+	}
+
+	return fmt.Sprintf("%s[%d]", result, node.Value)
+}
+
+// Define the pattern of a chain.
+type ChainPattern struct {
+	Kind  ChainKind   // The kind of the chain.
+	Nodes []ChainNode // The nodes of the chain, starting and ending with a strong link.
+}
+
+// Function to print the chain pattern, strong links as = and weak links as -.
+func (pattern ChainPattern) ToString() string {
+	parts := []string{}
+	for i, node := range pattern.Nodes {
+		parts = append(parts, node.ToString())
+		if i < len(pattern.Nodes)-1 {
+			if node.Link == ChainLinkStrong {
+				parts = append(parts, "=")
+			} else {
+				parts = append(parts, "-")
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s %s", chainKindNames[pattern.Kind], strings.Join(parts, " "))
+}
+
+// Define the graph of the chain nodes and their links.
+type chainGraph struct {
+	nodes  []ChainNode
+	strong [][]int // Indices of the nodes strongly linked to each node.
+	weak   [][]int // Indices of the nodes weakly linked to each node.
+}
+
+// Function to check if two nodes share a candidate.
+func nodesOverlap(first ChainNode, second ChainNode) bool {
+	if first.Value != second.Value {
+		return false
+	}
+
+	for _, position := range first.Positions {
+		if containsPosition(second.Positions, position) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to check if at most one of two nodes can be true.
+func isWeaklyLinked(first ChainNode, second ChainNode) bool {
+	// Different values in the same single cell.
+	if first.Value != second.Value {
+		return len(first.Positions) == 1 && len(second.Positions) == 1 && first.Positions[0] == second.Positions[0]
+	}
+
+	// The same value in disjoint nodes seeing each other entirely.
+	if nodesOverlap(first, second) {
+		return false
+	}
+
+	for _, position := range first.Positions {
+		for _, other := range second.Positions {
+			if !position.IsPeerOf(other) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Function to check if at least one of two nodes must be true.
+func isStronglyLinked(grid *core.CandidateGrid, first ChainNode, second ChainNode) bool {
+	// The only two values of a bivalue cell.
+	if first.Value != second.Value {
+		if !isWeaklyLinked(first, second) {
+			return false
+		}
+
+		candidates := grid.GetCandidates(first.Positions[0])
+		return candidates.Count() == 2 && candidates.Contains(first.Value) && candidates.Contains(second.Value)
+	}
+
+	// The only positions of a value in a house.
+	if nodesOverlap(first, second) {
+		return false
+	}
+
+	positions := append(append([]core.Position{}, first.Positions...), second.Positions...)
+	for _, house := range positions[0].GetHouses() {
+		if !isInAllHouse(house, positions) {
+			continue
+		}
+
+		if len(grid.GetPositionsWithCandidate(house, first.Value)) == len(positions) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to check if all the positions are in the house.
+func isInAllHouse(house core.House, positions []core.Position) bool {
+	for _, position := range positions {
+		if !house.Contains(position) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Function to build the nodes of the chain kind.
+func buildChainNodes(grid *core.CandidateGrid, kind ChainKind) []ChainNode {
+	nodes := []ChainNode{}
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			candidates := grid.GetCandidates(position)
+			if kind == XYChain && candidates.Count() != 2 {
+				continue
+			}
+
+			for _, value := range candidates.Digits() {
+				nodes = append(nodes, ChainNode{Positions: []core.Position{position}, Value: value})
+			}
+		}
+	}
+
+	if kind != AlternatingInference {
+		return nodes
+	}
+
+	// Grouped nodes: two or three candidates of a value in the intersection of a box and a line.
+	for box := 0; box < 9; box++ {
+		boxHouse := core.NewBoxHouse(box)
+		for value := 1; value <= 9; value++ {
+			positions := grid.GetPositionsWithCandidate(boxHouse, value)
+			for i := 0; i < 3; i++ {
+				for _, line := range []core.House{core.NewRowHouse(box/3*3 + i), core.NewColumnHouse(box%3*3 + i)} {
+					group := []core.Position{}
+					for _, position := range positions {
+						if line.Contains(position) {
+							group = append(group, position)
+						}
+					}
+
+					if len(group) >= 2 {
+						nodes = append(nodes, ChainNode{Positions: group, Value: value})
+					}
+				}
+			}
+		}
+	}
+
+	return nodes
+}
+
+// Function to build the graph of the chain kind.
+func buildChainGraph(grid *core.CandidateGrid, kind ChainKind) chainGraph {
+	nodes := buildChainNodes(grid, kind)
+	graph := chainGraph{
+		nodes:  nodes,
+		strong: make([][]int, len(nodes)),
+		weak:   make([][]int, len(nodes)),
+	}
+
+	for i := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			first, second := nodes[i], nodes[j]
+
+			// X-Chains stay on one value, XY-Chains use the cells for the strong links and the values for the weak links.
+			if kind == XChain && first.Value != second.Value {
+				continue
+			}
+
+			if isWeaklyLinked(first, second) && !(kind == XYChain && first.Value != second.Value) {
+				graph.weak[i] = append(graph.weak[i], j)
+				graph.weak[j] = append(graph.weak[j], i)
+			}
+
+			if isStronglyLinked(grid, first, second) && !(kind == XYChain && first.Value == second.Value) {
+				graph.strong[i] = append(graph.strong[i], j)
+				graph.strong[j] = append(graph.strong[j], i)
+			}
+		}
+	}
+
+	return graph
+}
+
+// Function to collect the candidates of the grid weakly linked to a node.
+func collectWeaklyLinkedCandidates(grid *core.CandidateGrid, node ChainNode) []ChainNode {
+	candidates := []ChainNode{}
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			for _, value := range grid.GetCandidates(position).Digits() {
+				candidate := ChainNode{Positions: []core.Position{position}, Value: value}
+				if isWeaklyLinked(candidate, node) {
+					candidates = append(candidates, candidate)
+				}
+			}
+		}
+	}
+
+	return candidates
+}
+
+// Define a search state of the chain: a node reached by a strong link (on) or by a weak link (off).
+type chainState struct {
+	node int
+	on   bool
+}
+
+// Function to search the shortest chain starting with a strong link from the start node, and ending with a strong link.
+// Either end of such a chain is true, so the candidates weakly linked to both ends can be removed.
+// Return the indices of the nodes and the eliminations, or nil if there is none.
+func searchChainFrom(grid *core.CandidateGrid, graph chainGraph, start int, maximumLength int) ([]int, []core.Cell) {
+	startCandidates := collectWeaklyLinkedCandidates(grid, graph.nodes[start])
+	if len(startCandidates) == 0 {
+		return nil, nil
+	}
+
+	startState := chainState{node: start, on: false}
+	visited := map[chainState]bool{startState: true}
+	queue := [][]int{{start}}
+	states := []chainState{startState}
+
+	for len(queue) > 0 {
+		path, state := queue[0], states[0]
+		queue, states = queue[1:], states[1:]
+
+		if len(path) >= maximumLength {
+			continue
+		}
+
+		// From an off node, a strong link turns the next node on. From an on node, a weak link turns it off.
+		neighbors := graph.strong[state.node]
+		if state.on {
+			neighbors = graph.weak[state.node]
+		}
+
+		for _, neighbor := range neighbors {
+			next := chainState{node: neighbor, on: !state.on}
+			if visited[next] || !canExtendChain(graph, path, neighbor) {
+				continue
+			}
+
+			visited[next] = true
+			nextPath := append(append([]int{}, path...), neighbor)
+			queue = append(queue, nextPath)
+			states = append(states, next)
+
+			if next.on && len(nextPath) >= 4 {
+				eliminations := []core.Cell{}
+				for _, candidate := range startCandidates {
+					if isWeaklyLinked(candidate, graph.nodes[neighbor]) {
+						eliminations = append(eliminations, core.NewCell(candidate.Positions[0], candidate.Value))
+					}
+				}
+
+				if len(eliminations) > 0 {
+					return nextPath, eliminations
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// Function to check that a node does not overlap the nodes already in the chain.
+func canExtendChain(graph chainGraph, path []int, node int) bool {
+	for _, index := range path {
+		if index == node || nodesOverlap(graph.nodes[index], graph.nodes[node]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Function to find the shortest chain of the kind with eliminations.
+func findChainStep(grid *core.CandidateGrid, kind ChainKind, maximumLength int) *SolveStep {
+	graph := buildChainGraph(grid, kind)
+
+	var bestPath []int
+	var bestEliminations []core.Cell
+	for start := range graph.nodes {
+		if len(graph.strong[start]) == 0 {
+			continue
+		}
+
+		path, eliminations := searchChainFrom(grid, graph, start, maximumLength)
+		if path != nil && (bestPath == nil || len(path) < len(bestPath)) {
+			bestPath, bestEliminations = path, eliminations
+		}
+	}
+
+	if bestPath == nil {
+		return nil
+	}
+
+	nodes := make([]ChainNode, len(bestPath))
+	for i, index := range bestPath {
+		nodes[i] = graph.nodes[index]
+		nodes[i].Link = ChainLinkWeak
+		if i%2 == 0 {
+			nodes[i].Link = ChainLinkStrong
+		}
+	}
+
+	return &SolveStep{
+		Eliminations: bestEliminations,
+		Pattern:      ChainPattern{Kind: kind, Nodes: nodes},
+	}
+}
@@ -0,0 +1,254 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the coloring solver object.
+type ColoringSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default ColoringSolver object.
+func NewColoringSolver() ColoringSolver {
+	return ColoringSolver{
+		newStrategySolver(BaseSolver{
+			Key:         "coloring",
+			DisplayName: "Coloring Solver",
+			Description: `Strategy solver using singles and coloring of the conjugate pair chains of a value: color trap, color wrap and multi-coloring.`,
+			Reliable:    false,
+		}, findColoringStep),
+	}
+}
+
+// Define the coloring rules.
+type ColoringRule int
+
+const (
+	ColorTrap      ColoringRule = iota // A cell seeing both colors cannot hold the value.
+	ColorWrap                          // Two cells of the same color see each other, so that color is false.
+	MultiColorTrap                     // Two clusters linked by a weak link, a cell seeing both opposite colors cannot hold the value.
+	MultiColorWrap                     // A color seeing both colors of another cluster is false.
+)
+
+// Names of the coloring rules.
+var coloringRuleNames = map[ColoringRule]string{
+	ColorTrap:      "color trap",
+	ColorWrap:      "color wrap",
+	MultiColorTrap: "multi-color trap",
+	MultiColorWrap: "multi-color wrap",
+}
+
+// Define the pattern of a coloring step.
+type ColoringPattern struct {
+	Rule        ColoringRule        // The rule applied.
+	Value       int                 // The colored value.
+	Colors      [2][]core.Position  // The two color classes of the cluster.
+	OtherColors *[2][]core.Position // The two color classes of the other cluster in multi-coloring, nil otherwise.
+}
+
+// Function to print the coloring pattern.
+func (pattern ColoringPattern) ToString() string {
+	result := fmt.Sprintf("%s on %d with colors [%s] and [%s]",
+		coloringRuleNames[pattern.Rule], pattern.Value, formatPositions(pattern.Colors[0]), formatPositions(pattern.Colors[1]))
+	if pattern.OtherColors != nil {
+		result += fmt.Sprintf(", other colors [%s] and [%s]", formatPositions(pattern.OtherColors[0]), formatPositions(pattern.OtherColors[1]))
+	}
+
+	return result
+}
+
+// Function to build the clusters of the value: the connected components of its strong links, colored in two classes.
+// A cluster that cannot be colored consistently is skipped.
+func buildColorClusters(grid *core.CandidateGrid, value int) [][2][]core.Position {
+	links := findStrongLinks(grid, value)
+
+	neighbors := map[core.Position][]core.Position{}
+	nodes := []core.Position{}
+	for _, link := range links {
+		for i, end := range link.Ends {
+			if _, ok := neighbors[end]; !ok {
+				nodes = append(nodes, end)
+			}
+			neighbors[end] = append(neighbors[end], link.Ends[1-i])
+		}
+	}
+
+	clusters := [][2][]core.Position{}
+	colorOf := map[core.Position]int{}
+	for _, start := range nodes {
+		if _, ok := colorOf[start]; ok {
+			continue
+		}
+
+		cluster := [2][]core.Position{}
+		consistent := true
+		colorOf[start] = 0
+		queue := []core.Position{start}
+		for len(queue) > 0 {
+			position := queue[0]
+			queue = queue[1:]
+			cluster[colorOf[position]] = append(cluster[colorOf[position]], position)
+
+			for _, neighbor := range neighbors[position] {
+				if color, ok := colorOf[neighbor]; !ok {
+					colorOf[neighbor] = 1 - colorOf[position]
+					queue = append(queue, neighbor)
+				} else if color == colorOf[position] {
+					consistent = false
+				}
+			}
+		}
+
+		if consistent {
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	return clusters
+}
+
+// Function to check if a position sees any of the positions.
+func seesAny(position core.Position, positions []core.Position) bool {
+	for _, other := range positions {
+		if position.IsPeerOf(other) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to check if any two positions of a list see each other.
+func hasMutualPeers(positions []core.Position) bool {
+	for i, position := range positions {
+		if seesAny(position, positions[i+1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to collect the eliminations of the value from the uncolored cells seeing both given color classes.
+func collectTrapEliminations(grid *core.CandidateGrid, value int, first []core.Position, second []core.Position, colored [][]core.Position) []core.Cell {
+	eliminations := []core.Cell{}
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			if !grid.HasCandidate(position, value) || isColored(position, colored) {
+				continue
+			}
+
+			if seesAny(position, first) && seesAny(position, second) {
+				eliminations = append(eliminations, core.NewCell(position, value))
+			}
+		}
+	}
+
+	return eliminations
+}
+
+// Function to check if a position is in any of the color classes.
+func isColored(position core.Position, colored [][]core.Position) bool {
+	for _, positions := range colored {
+		if containsPosition(positions, position) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to apply simple coloring on a cluster: color wrap first, then color trap.
+func findSimpleColoring(grid *core.CandidateGrid, value int, cluster [2][]core.Position) *SolveStep {
+	for _, color := range cluster {
+		if hasMutualPeers(color) {
+			return &SolveStep{
+				Eliminations: collectEliminations(grid, color, value),
+				Pattern:      ColoringPattern{Rule: ColorWrap, Value: value, Colors: cluster},
+			}
+		}
+	}
+
+	eliminations := collectTrapEliminations(grid, value, cluster[0], cluster[1], cluster[:])
+	if len(eliminations) > 0 {
+		return &SolveStep{
+			Eliminations: eliminations,
+			Pattern:      ColoringPattern{Rule: ColorTrap, Value: value, Colors: cluster},
+		}
+	}
+
+	return nil
+}
+
+// Function to apply multi-coloring on two clusters.
+func findMultiColoring(grid *core.CandidateGrid, value int, cluster [2][]core.Position, other [2][]core.Position) *SolveStep {
+	for i, color := range cluster {
+		// If a color sees both colors of the other cluster, it is false.
+		if hasLinkBetween(color, other[0]) && hasLinkBetween(color, other[1]) {
+			return &SolveStep{
+				Eliminations: collectEliminations(grid, color, value),
+				Pattern:      ColoringPattern{Rule: MultiColorWrap, Value: value, Colors: cluster, OtherColors: &other},
+			}
+		}
+
+		// If a color sees a color of the other cluster, one of their opposite colors is true.
+		for j, otherColor := range other {
+			if !hasLinkBetween(color, otherColor) {
+				continue
+			}
+
+			eliminations := collectTrapEliminations(grid, value, cluster[1-i], other[1-j], [][]core.Position{cluster[0], cluster[1], other[0], other[1]})
+			if len(eliminations) > 0 {
+				return &SolveStep{
+					Eliminations: eliminations,
+					Pattern:      ColoringPattern{Rule: MultiColorTrap, Value: value, Colors: cluster, OtherColors: &other},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to check if any position of the first list sees any position of the second list.
+func hasLinkBetween(first []core.Position, second []core.Position) bool {
+	for _, position := range first {
+		if seesAny(position, second) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to find the next coloring step, simple coloring on every value before multi-coloring.
+func findColoringStep(grid *core.CandidateGrid) *SolveStep {
+	clustersByValue := map[int][][2][]core.Position{}
+	for value := 1; value <= 9; value++ {
+		clustersByValue[value] = buildColorClusters(grid, value)
+
+		for _, cluster := range clustersByValue[value] {
+			if step := findSimpleColoring(grid, value, cluster); step != nil {
+				return step
+			}
+		}
+	}
+
+	for value := 1; value <= 9; value++ {
+		for _, pair := range combinations(clustersByValue[value], 2) {
+			if step := findMultiColoring(grid, value, pair[0], pair[1]); step != nil {
+				return step
+			}
+
+			if step := findMultiColoring(grid, value, pair[1], pair[0]); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package solver
+
+import "context"
+
+// The number of search nodes between two checks of the context, as checking it takes a lock.
+const contextCheckInterval = 1024
+
+// Define a periodic check of the context of a search.
+type contextCheck struct {
+	ctx   context.Context
+	nodes int   // The number of search nodes visited.
+	err   error // The error of the context once it is done.
+}
+
+// Constructor like function to create a contextCheck object.
+func newContextCheck(ctx context.Context) contextCheck {
+	return contextCheck{ctx: ctx}
+}
+
+// Function to check if the search should stop because the context is done, checking the context once every interval of nodes.
+func (check *contextCheck) isDone() bool {
+	if check.err == nil {
+		check.nodes++
+		if check.nodes%contextCheckInterval == 0 {
+			check.err = check.ctx.Err()
+		}
+	}
+
+	return check.err != nil
+}
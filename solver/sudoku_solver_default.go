@@ -1,6 +1,8 @@
 package solver
 
 import (
+	"context"
+
 	"github.com/gnailuy/sudoku/core"
 	"github.com/gnailuy/sudoku/util"
 )
@@ -8,55 +10,73 @@ import (
 // Define the default solver object.
 type DefaultSolver struct {
 	BaseSolver
+	random util.IRandomSource // The source of the random candidate order.
 }
 
 // Constructor like function to create a default DefaultSolver object.
 func NewDefaultSolver() DefaultSolver {
 	return DefaultSolver{
-		BaseSolver{
+		BaseSolver: BaseSolver{
 			Key:         "default",
 			DisplayName: "Default Solver",
 			Description: `Default solver using recursive backtracking in a random order.`,
 			Reliable:    true,
 		},
+		random: util.GlobalRandomSource,
 	}
 }
 
+// Function to get a copy of the solver making its random choices with the source.
+func (solver DefaultSolver) WithRandomSource(source util.IRandomSource) ISudokuSolver {
+	solver.random = source
+	return solver
+}
+
 // Define the internal options for the solve function.
 type solveOptions struct {
-	Randomly       bool  // Randomly generate candidate numbers. When counting solutions, this option is ignored.
-	HintOnly       bool  // Only generate a solve path for hint generation without solving the board.
-	CountSolutions bool  // Count the number of solutions instead of returning the first solution, default is false.
-	RowOrder       []int // Order of rows to generate candidate positions.
-	ColumnOrder    []int // Order of columns to generate candidate positions.
+	Randomly       bool               // Randomly generate candidate numbers. When counting solutions, this option is ignored.
+	Random         util.IRandomSource // The source of the random order.
+	HintOnly       bool               // Only generate a solve path for hint generation without solving the board.
+	CountSolutions bool               // Count the number of solutions instead of returning the first solution, default is false.
+	SolutionsLimit int                // Stop counting the solutions when reaching this number, 0 means no limit.
+	RowOrder       []int              // Order of rows to generate candidate positions.
+	ColumnOrder    []int              // Order of columns to generate candidate positions.
 }
 
 // Constructor like function to create a new solveOptions object.
-func newSolveOptions(randomly, hintOnly, countSolutions bool) solveOptions {
+func newSolveOptions(random util.IRandomSource, randomly, hintOnly, countSolutions bool) solveOptions {
 	return solveOptions{
 		Randomly:       randomly,
+		Random:         random,
 		HintOnly:       hintOnly,
 		CountSolutions: countSolutions,
-		RowOrder:       util.GenerateNumberArray(0, 9, randomly),
-		ColumnOrder:    util.GenerateNumberArray(0, 9, randomly),
+		RowOrder:       util.GenerateNumberArrayWith(random, 0, 9, randomly),
+		ColumnOrder:    util.GenerateNumberArrayWith(random, 0, 9, randomly),
 	}
 }
 
 // Internal state struct for the recursive backtracking solver.
 type solveState struct {
+	contextCheck
+	statsRecorder
 	numberOfSolutions int
 	solvePath         []core.Cell
 }
 
 // Function to solve the Sudoku board using backtracking.
 func solve(board *core.SudokuBoard, state *solveState, options solveOptions) bool {
+	state.visit(len(state.solvePath))
+	if state.isDone() {
+		return false
+	}
+
 	for _, row := range options.RowOrder {
 		for _, column := range options.ColumnOrder {
 			position := core.NewPosition(row, column)
 
 			if board.Get(position) == 0 {
 				// When counting solutions, we do not need to generate candidate values randomly.
-				candidateValues := util.GenerateNumberArray(1, 10, !options.CountSolutions && options.Randomly)
+				candidateValues := util.GenerateNumberArrayWith(options.Random, 1, 10, !options.CountSolutions && options.Randomly)
 
 				for _, value := range candidateValues {
 					// Try to place a value in the cell and solve the board recursively if it is valid.
@@ -76,6 +96,17 @@ func solve(board *core.SudokuBoard, state *solveState, options solveOptions) boo
 
 						board.Unset(position)
 						state.solvePath = state.solvePath[:len(state.solvePath)-1]
+						state.stats.Backtracks++
+
+						// Stop when the context is done.
+						if state.err != nil {
+							return false
+						}
+
+						// Stop counting when the limit is reached, returning false so that no caller counts another solution.
+						if options.CountSolutions && options.SolutionsLimit > 0 && state.numberOfSolutions >= options.SolutionsLimit {
+							return false
+						}
 					}
 				}
 
@@ -96,12 +127,31 @@ func solve(board *core.SudokuBoard, state *solveState, options solveOptions) boo
 
 // Function to solve the Sudoku board with random candidate values.
 func (solver DefaultSolver) Solve(board *core.SudokuBoard) bool {
+	solved, _ := solver.SolveContext(context.Background(), board)
+	return solved
+}
+
+// Function to solve the Sudoku board with random candidate values, stopping when the context is done.
+func (solver DefaultSolver) SolveContext(ctx context.Context, board *core.SudokuBoard) (bool, error) {
+	return solver.SolveWithStats(ctx, board, nil)
+}
+
+// Function to solve the Sudoku board with random candidate values, adding the statistics of the search to the stats if not nil.
+// The backtracking solver guesses every value, so there is no propagation.
+func (solver DefaultSolver) SolveWithStats(ctx context.Context, board *core.SudokuBoard, stats *SolveStats) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
 	if !board.IsValid() {
-		return false
+		return false, nil
 	}
 
-	state := &solveState{}
-	return solve(board, state, newSolveOptions(true, false, false))
+	state := &solveState{contextCheck: newContextCheck(ctx), statsRecorder: newStatsRecorder()}
+	solved := solve(board, state, newSolveOptions(solver.random, true, false, false))
+	state.report(stats)
+
+	return solved, state.err
 }
 
 // Function to generate a hint for the Sudoku board without solving the board.
@@ -110,8 +160,8 @@ func (solver DefaultSolver) Hint(board *core.SudokuBoard) *core.Cell {
 		return nil
 	}
 
-	state := &solveState{}
-	solve(board, state, newSolveOptions(true, true, false))
+	state := &solveState{contextCheck: newContextCheck(context.Background()), statsRecorder: newStatsRecorder()}
+	solve(board, state, newSolveOptions(solver.random, true, true, false))
 
 	if len(state.solvePath) > 0 {
 		return &state.solvePath[0]
@@ -123,18 +173,43 @@ func (solver DefaultSolver) Hint(board *core.SudokuBoard) *core.Cell {
 // Function to count the number of solutions for the Sudoku board.
 // Note that if the board is already solved, we return 1 as doing nothing is also a solution.
 func (solver DefaultSolver) CountSolutions(board *core.SudokuBoard) int {
+	return solver.CountSolutionsUpTo(board, 0)
+}
+
+// Function to count the number of solutions for the Sudoku board, stopping at the limit.
+func (solver DefaultSolver) CountSolutionsUpTo(board *core.SudokuBoard, limit int) int {
+	count, _ := solver.CountSolutionsUpToContext(context.Background(), board, limit)
+	return count
+}
+
+// Function to count the number of solutions for the Sudoku board, stopping at the limit or when the context is done.
+func (solver DefaultSolver) CountSolutionsUpToContext(ctx context.Context, board *core.SudokuBoard, limit int) (int, error) {
+	return solver.CountSolutionsWithStats(ctx, board, limit, nil)
+}
+
+// Function to count the number of solutions for the Sudoku board up to the limit, adding the statistics of the search to the stats if not nil.
+func (solver DefaultSolver) CountSolutionsWithStats(ctx context.Context, board *core.SudokuBoard, limit int, stats *SolveStats) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
 	// If the board is already solved, return 1.
 	if board.IsSolved() {
-		return 1
+		return 1, nil
 	}
 
 	// If there is any invalid cell, the board is not solvable, return 0.
 	if !board.IsValid() {
-		return 0
+		return 0, nil
 	}
 
 	// If no invalid cell, we can count the number of solutions.
-	state := &solveState{}
-	solve(board, state, newSolveOptions(false, false, true))
-	return state.numberOfSolutions
+	state := &solveState{contextCheck: newContextCheck(ctx), statsRecorder: newStatsRecorder()}
+	options := newSolveOptions(solver.random, false, false, true)
+	options.SolutionsLimit = limit
+
+	solve(board, state, options)
+	state.report(stats)
+
+	return state.numberOfSolutions, state.err
 }
@@ -0,0 +1,347 @@
+package solver
+
+import (
+	"context"
+
+	"github.com/gnailuy/sudoku/core"
+	"github.com/gnailuy/sudoku/util"
+)
+
+// Define the dancing links solver object.
+type DancingLinksSolver struct {
+	BaseSolver
+	random util.IRandomSource // The source of the random choices.
+}
+
+// Constructor like function to create a default DancingLinksSolver object.
+func NewDancingLinksSolver() DancingLinksSolver {
+	return DancingLinksSolver{
+		BaseSolver: BaseSolver{
+			Key:         "dlx",
+			DisplayName: "Dancing Links Solver",
+			Description: `Exact cover solver using Knuth's Algorithm X with dancing links, always branching on the constraint with the fewest candidates.`,
+			Reliable:    true,
+		},
+		random: util.GlobalRandomSource,
+	}
+}
+
+// The exact cover matrix of a Sudoku board has 324 constraint columns: each cell holds a value,
+// and each row, column and box holds each value once. Each of the 729 candidate rows covers 4 columns.
+const (
+	dancingLinksColumns    = 4 * 81
+	dancingLinksCandidates = 9 * 81
+)
+
+// Define the dancing links matrix. The node 0 is the root header, the nodes 1 to 324 are the column headers,
+// followed by the 4 nodes of each candidate row.
+type dancingLinks struct {
+	left, right, up, down []int
+	column                []int // The column header of each node.
+	candidate             []int // The candidate row of each node, row * 81 + column * 9 + value - 1.
+	size                  []int // The number of nodes in each column.
+}
+
+// Function to get the 4 constraint columns covered by a candidate, numbered from 1.
+func getCandidateColumns(row int, column int, value int) [4]int {
+	box := row/3*3 + column/3
+	return [4]int{
+		1 + row*9 + column,
+		1 + 81 + row*9 + value - 1,
+		1 + 2*81 + column*9 + value - 1,
+		1 + 3*81 + box*9 + value - 1,
+	}
+}
+
+// Constructor like function to create the dancing links matrix of a board, with the filled cells already selected.
+// Return nil if the filled cells conflict with each other.
+func newDancingLinks(board *core.SudokuBoard) *dancingLinks {
+	numberOfNodes := 1 + dancingLinksColumns + 4*dancingLinksCandidates
+	links := &dancingLinks{
+		left:      make([]int, numberOfNodes),
+		right:     make([]int, numberOfNodes),
+		up:        make([]int, numberOfNodes),
+		down:      make([]int, numberOfNodes),
+		column:    make([]int, numberOfNodes),
+		candidate: make([]int, numberOfNodes),
+		size:      make([]int, 1+dancingLinksColumns),
+	}
+
+	// Link the headers in a circular list starting from the root.
+	for i := 0; i <= dancingLinksColumns; i++ {
+		links.left[i] = (i + dancingLinksColumns) % (dancingLinksColumns + 1)
+		links.right[i] = (i + 1) % (dancingLinksColumns + 1)
+		links.up[i], links.down[i], links.column[i] = i, i, i
+	}
+
+	// Append the 4 nodes of every candidate row to the bottom of their columns.
+	node := dancingLinksColumns + 1
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			for value := 1; value <= 9; value++ {
+				first := node
+				for i, header := range getCandidateColumns(row, column, value) {
+					links.column[node] = header
+					links.candidate[node] = row*81 + column*9 + value - 1
+					links.up[node], links.down[node] = links.up[header], header
+					links.down[links.up[header]] = node
+					links.up[header] = node
+					links.size[header]++
+
+					links.left[node], links.right[node] = first+(i+3)%4, first+(i+1)%4
+					node++
+				}
+			}
+		}
+	}
+
+	// Select the candidate rows of the filled cells.
+	covered := make([]bool, 1+dancingLinksColumns)
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			value := board.Get(core.NewPosition(row, column))
+			if value == 0 {
+				continue
+			}
+
+			for _, header := range getCandidateColumns(row, column, value) {
+				if covered[header] {
+					return nil
+				}
+
+				covered[header] = true
+				links.cover(header)
+			}
+		}
+	}
+
+	return links
+}
+
+// Function to remove a column and the candidate rows covering it from the matrix.
+func (links *dancingLinks) cover(header int) {
+	links.right[links.left[header]] = links.right[header]
+	links.left[links.right[header]] = links.left[header]
+
+	for i := links.down[header]; i != header; i = links.down[i] {
+		for j := links.right[i]; j != i; j = links.right[j] {
+			links.down[links.up[j]] = links.down[j]
+			links.up[links.down[j]] = links.up[j]
+			links.size[links.column[j]]--
+		}
+	}
+}
+
+// Function to restore a column removed by cover, in the reverse order.
+func (links *dancingLinks) uncover(header int) {
+	for i := links.up[header]; i != header; i = links.up[i] {
+		for j := links.left[i]; j != i; j = links.left[j] {
+			links.size[links.column[j]]++
+			links.down[links.up[j]] = j
+			links.up[links.down[j]] = j
+		}
+	}
+
+	links.right[links.left[header]] = header
+	links.left[links.right[header]] = header
+}
+
+// Define the state of a dancing links search.
+type dancingLinksSearch struct {
+	contextCheck
+	statsRecorder
+	random            util.IRandomSource // The source to try the candidate rows of a column in a random order, nil to keep the order.
+	limit             int                // Stop after finding this number of solutions, 0 means no limit.
+	numberOfSolutions int                // The number of solutions found.
+	selected          []int              // The candidate rows currently selected.
+	firstSolution     []int              // The candidate rows of the first solution found.
+}
+
+// Function to search the exact covers of the matrix, return true if the search should stop.
+func (links *dancingLinks) search(state *dancingLinksSearch) bool {
+	state.visit(len(state.selected))
+	if state.isDone() {
+		return true
+	}
+
+	// All the columns are covered, a solution is found.
+	if links.right[0] == 0 {
+		state.numberOfSolutions++
+		if state.firstSolution == nil {
+			state.firstSolution = append([]int{}, state.selected...)
+		}
+
+		return state.limit > 0 && state.numberOfSolutions >= state.limit
+	}
+
+	// Branch on the column with the fewest candidate rows.
+	header := links.right[0]
+	for i := links.right[header]; i != 0; i = links.right[i] {
+		if links.size[i] < links.size[header] {
+			header = i
+		}
+	}
+
+	if links.size[header] == 0 {
+		return false
+	}
+
+	// A constraint with a single candidate left forces it.
+	if links.size[header] == 1 {
+		state.stats.Propagations++
+	}
+
+	rows := []int{}
+	for i := links.down[header]; i != header; i = links.down[i] {
+		rows = append(rows, i)
+	}
+
+	if state.random != nil {
+		util.ShuffleArrayWith(state.random, rows)
+	}
+
+	links.cover(header)
+	defer links.uncover(header)
+
+	for _, i := range rows {
+		state.selected = append(state.selected, links.candidate[i])
+		for j := links.right[i]; j != i; j = links.right[j] {
+			links.cover(links.column[j])
+		}
+
+		stop := links.search(state)
+
+		for j := links.left[i]; j != i; j = links.left[j] {
+			links.uncover(links.column[j])
+		}
+		state.selected = state.selected[:len(state.selected)-1]
+
+		if stop {
+			return true
+		}
+
+		state.stats.Backtracks++
+	}
+
+	return false
+}
+
+// Function to search the board until the context is done, return the search state or nil if the filled cells conflict.
+func searchDancingLinks(ctx context.Context, board *core.SudokuBoard, random util.IRandomSource, limit int) *dancingLinksSearch {
+	links := newDancingLinks(board)
+	if links == nil {
+		return nil
+	}
+
+	state := &dancingLinksSearch{contextCheck: newContextCheck(ctx), statsRecorder: newStatsRecorder(), random: random, limit: limit}
+	links.search(state)
+
+	return state
+}
+
+// Function to get the cells of a list of candidate rows.
+func getCandidateCells(candidates []int) []core.Cell {
+	cells := make([]core.Cell, len(candidates))
+	for i, candidate := range candidates {
+		cells[i] = core.NewCell(core.NewPosition(candidate/81, candidate/9%9), candidate%9+1)
+	}
+
+	return cells
+}
+
+// Function to get a copy of the solver making its random choices with the source.
+func (solver DancingLinksSolver) WithRandomSource(source util.IRandomSource) ISudokuSolver {
+	solver.random = source
+	return solver
+}
+
+// Function to solve the Sudoku board, picking a random solution if there are several.
+func (solver DancingLinksSolver) Solve(board *core.SudokuBoard) bool {
+	solved, _ := solver.SolveContext(context.Background(), board)
+	return solved
+}
+
+// Function to solve the Sudoku board, picking a random solution if there are several, stopping when the context is done.
+func (solver DancingLinksSolver) SolveContext(ctx context.Context, board *core.SudokuBoard) (bool, error) {
+	return solver.SolveWithStats(ctx, board, nil)
+}
+
+// Function to solve the Sudoku board like SolveContext, adding the statistics of the search to the stats if not nil.
+func (solver DancingLinksSolver) SolveWithStats(ctx context.Context, board *core.SudokuBoard, stats *SolveStats) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	if !board.IsValid() {
+		return false, nil
+	}
+
+	state := searchDancingLinks(ctx, board, solver.random, 1)
+	if state == nil {
+		return false, nil
+	}
+
+	state.report(stats)
+
+	if state.firstSolution == nil {
+		return false, state.err
+	}
+
+	for _, cell := range getCandidateCells(state.firstSolution) {
+		board.SetCell(cell)
+	}
+
+	return true, nil
+}
+
+// Function to give the value of a random empty cell in a solution as a hint, without solving the board.
+func (solver DancingLinksSolver) Hint(board *core.SudokuBoard) *core.Cell {
+	if !board.IsValid() {
+		return nil
+	}
+
+	state := searchDancingLinks(context.Background(), board, solver.random, 1)
+	if state == nil || len(state.firstSolution) == 0 {
+		return nil
+	}
+
+	cells := getCandidateCells(state.firstSolution)
+	return &cells[util.RandomIntWith(solver.random, 0, len(cells))]
+}
+
+// Function to count the number of solutions for the Sudoku board.
+// Note that if the board is already solved, we return 1 as doing nothing is also a solution.
+func (solver DancingLinksSolver) CountSolutions(board *core.SudokuBoard) int {
+	return solver.CountSolutionsUpTo(board, 0)
+}
+
+// Function to count the number of solutions for the Sudoku board, stopping at the limit.
+func (solver DancingLinksSolver) CountSolutionsUpTo(board *core.SudokuBoard, limit int) int {
+	count, _ := solver.CountSolutionsUpToContext(context.Background(), board, limit)
+	return count
+}
+
+// Function to count the number of solutions for the Sudoku board, stopping at the limit or when the context is done.
+func (solver DancingLinksSolver) CountSolutionsUpToContext(ctx context.Context, board *core.SudokuBoard, limit int) (int, error) {
+	return solver.CountSolutionsWithStats(ctx, board, limit, nil)
+}
+
+// Function to count the number of solutions for the Sudoku board up to the limit, adding the statistics of the search to the stats if not nil.
+func (solver DancingLinksSolver) CountSolutionsWithStats(ctx context.Context, board *core.SudokuBoard, limit int, stats *SolveStats) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if !board.IsValid() {
+		return 0, nil
+	}
+
+	state := searchDancingLinks(ctx, board, nil, limit)
+	if state == nil {
+		return 0, nil
+	}
+
+	state.report(stats)
+
+	return state.numberOfSolutions, state.err
+}
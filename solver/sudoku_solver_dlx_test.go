@@ -0,0 +1,61 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Test the dancing links solver on a hard board with a unique solution.
+func TestDancingLinksSolver(t *testing.T) {
+	solver := NewDancingLinksSolver()
+	if !solver.IsReliable() {
+		t.Error("Expected the dancing links solver to be reliable")
+	}
+
+	board := newBoardFromString("4.....8.5.3..........7......2.....6.....8.4......1.......6.3.7.5..2.....1.4......")
+	solution := "417369825632158947958724316825437169791586432346912758289643571573291684164875293"
+
+	if count := solver.CountSolutions(&board); count != 1 {
+		t.Errorf("Expected 1 solution, got %d", count)
+	}
+
+	hint := solver.Hint(&board)
+	if hint == nil || board.Get(hint.Position) != 0 || solution[hint.Position.Row*9+hint.Position.Column]-'0' != byte(hint.Value) {
+		t.Errorf("Unexpected hint: %v", hint)
+	}
+
+	if !solver.Solve(&board) || board.ToString() != solution {
+		t.Errorf("Unexpected solution: %s", board.ToString())
+	}
+
+	if count := solver.CountSolutions(&board); count != 1 {
+		t.Errorf("Expected 1 solution for a solved board, got %d", count)
+	}
+
+	if solver.Hint(&board) != nil {
+		t.Error("Expected no hint for a solved board")
+	}
+}
+
+// Test the dancing links solver counts the same solutions as the default solver.
+func TestDancingLinksSolverCount(t *testing.T) {
+	// Removing clues from a solved board gives several solutions.
+	board := newBoardFromString("583167294672394815149825376934678521267451983851932467316589742795246138428713659")
+	for row := 0; row < 9; row += 2 {
+		for column := 0; column < 9; column++ {
+			board.Unset(core.NewPosition(row, column))
+		}
+	}
+
+	expected := NewDefaultSolver().CountSolutions(&board)
+	if count := NewDancingLinksSolver().CountSolutions(&board); count != expected || count < 2 {
+		t.Errorf("Expected %d solutions, got %d", expected, count)
+	}
+
+	// An empty board can be solved.
+	empty := core.NewEmptySudokuBoard()
+	if !NewDancingLinksSolver().Solve(&empty) || !empty.IsSolved() || !empty.IsValid() {
+		t.Errorf("Unexpected solution of the empty board: %s", empty.ToString())
+	}
+}
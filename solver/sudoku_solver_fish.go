@@ -0,0 +1,346 @@
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Names and keys of the fish by size.
+var fishNames = map[int]string{2: "X-Wing", 3: "Swordfish", 4: "Jellyfish"}
+var fishKeys = map[int]string{2: "xwing", 3: "swordfish", 4: "jellyfish"}
+
+// Define the fish variants.
+type FishVariant int
+
+const (
+	BasicFish   FishVariant = iota // All the candidates of the base sets are in the cover sets.
+	FinnedFish                     // Some extra candidates (fins) in the base sets are in one box.
+	SashimiFish                    // A finned fish with a base set left with a single candidate in the cover sets.
+)
+
+// Names of the fish variants.
+var fishVariantNames = map[FishVariant]string{BasicFish: "", FinnedFish: "finned", SashimiFish: "sashimi"}
+
+// Define the fish solver object.
+type FishSolver struct {
+	StrategySolver
+	Size    int         // The number of base sets: 2 for X-Wing, 3 for Swordfish and 4 for Jellyfish.
+	Variant FishVariant // The variant of the fish.
+}
+
+// Constructor like function to create a basic FishSolver object of the given size.
+func NewFishSolver(size int) FishSolver {
+	return newFishSolver(size, BasicFish)
+}
+
+// Constructor like function to create a finned FishSolver object of the given size.
+func NewFinnedFishSolver(size int) FishSolver {
+	return newFishSolver(size, FinnedFish)
+}
+
+// Constructor like function to create a sashimi FishSolver object of the given size.
+func NewSashimiFishSolver(size int) FishSolver {
+	return newFishSolver(size, SashimiFish)
+}
+
+func newFishSolver(size int, variant FishVariant) FishSolver {
+	if size < 2 || size > 4 {
+		panic("Bug: Invalid fish size: " + fmt.Sprint(size))
+	}
+
+	key, name := fishKeys[size], fishNames[size]
+	description := fmt.Sprintf(`Strategy solver using singles and the %s: a value locked in %d rows (or columns) removed from %d covering columns (or rows).`, name, size, size)
+	if variant != BasicFish {
+		key = fishVariantNames[variant] + "-" + key
+		name = strings.ToUpper(fishVariantNames[variant][:1]) + fishVariantNames[variant][1:] + " " + name
+		description = fmt.Sprintf(`Strategy solver using singles and the %s: a %s with fins in one box, removing the value only from the cover sets in that box.`, name, fishNames[size])
+	}
+
+	return FishSolver{
+		StrategySolver: newStrategySolver(BaseSolver{
+			Key:         key,
+			DisplayName: name + " Solver",
+			Description: description,
+			Reliable:    false,
+		}, func(grid *core.CandidateGrid) *SolveStep {
+			return findFishStep(grid, size, variant)
+		}),
+		Size:    size,
+		Variant: variant,
+	}
+}
+
+// Define the pattern of a fish.
+type FishPattern struct {
+	Value     int             // The value of the fish.
+	Variant   FishVariant     // The variant of the fish.
+	BaseSets  []core.House    // The houses where the value is restricted to the cover sets and the fins.
+	CoverSets []core.House    // The houses where the value is removed outside of the base sets.
+	Fins      []core.Position // The candidates of the base sets outside of the cover sets, empty for a basic fish.
+}
+
+// Function to print the fish pattern.
+func (pattern FishPattern) ToString() string {
+	name := fishNames[len(pattern.BaseSets)]
+	if pattern.Variant != BasicFish {
+		name = fishVariantNames[pattern.Variant] + " " + name
+	}
+
+	result := fmt.Sprintf("%s on %d with base %s and cover %s",
+		name, pattern.Value, formatHouses(pattern.BaseSets), formatHouses(pattern.CoverSets))
+	if len(pattern.Fins) > 0 {
+		result += " and fins at " + formatPositions(pattern.Fins)
+	}
+
+	return result
+}
+
+// Function to format a list of houses as a user facing string.
+func formatHouses(houses []core.House) string {
+	parts := make([]string, len(houses))
+	for i, house := range houses {
+		parts[i] = house.ToString()
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Function to get the index of the house of the given type containing the position.
+func getLineIndex(position core.Position, houseType core.HouseType) int {
+	if houseType == core.RowHouse {
+		return position.Row
+	}
+
+	return position.Column
+}
+
+// Function to find a fish of the value with base sets of the given type.
+func findFishOn(grid *core.CandidateGrid, value int, size int, baseType core.HouseType) *SolveStep {
+	coverType := getCoverType(baseType)
+
+	// Only the lines where the value fits in 2 to size positions can be base sets.
+	baseCandidates := []core.House{}
+	for i := 0; i < 9; i++ {
+		house := core.NewHouse(baseType, i)
+		count := len(grid.GetPositionsWithCandidate(house, value))
+		if count >= 2 && count <= size {
+			baseCandidates = append(baseCandidates, house)
+		}
+	}
+
+	for _, baseSets := range combinations(baseCandidates, size) {
+		coverSets := []core.House{}
+		for _, base := range baseSets {
+			for _, position := range grid.GetPositionsWithCandidate(base, value) {
+				cover := core.NewHouse(coverType, getLineIndex(position, coverType))
+				if !containsHouse(coverSets, cover) {
+					coverSets = append(coverSets, cover)
+				}
+			}
+		}
+
+		if len(coverSets) != size {
+			continue
+		}
+
+		sortHouses(coverSets)
+
+		eliminations := []core.Cell{}
+		for _, cover := range coverSets {
+			for _, position := range grid.GetPositionsWithCandidate(cover, value) {
+				if !isInAnyHouse(baseSets, position) {
+					eliminations = append(eliminations, core.NewCell(position, value))
+				}
+			}
+		}
+
+		if len(eliminations) > 0 {
+			return &SolveStep{
+				Eliminations: eliminations,
+				Pattern:      FishPattern{Value: value, BaseSets: baseSets, CoverSets: coverSets},
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to check if a house is in a list of houses.
+func containsHouse(houses []core.House, house core.House) bool {
+	for _, other := range houses {
+		if other == house {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to sort a list of houses of the same type by index.
+func sortHouses(houses []core.House) {
+	sort.Slice(houses, func(i, j int) bool {
+		return houses[i].Index < houses[j].Index
+	})
+}
+
+// Function to check if a position is in any of the houses.
+func isInAnyHouse(houses []core.House, position core.Position) bool {
+	for _, house := range houses {
+		if house.Contains(position) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to get the other line type: columns for rows and rows for columns.
+func getCoverType(baseType core.HouseType) core.HouseType {
+	if baseType == core.ColumnHouse {
+		return core.RowHouse
+	}
+
+	return core.ColumnHouse
+}
+
+// Function to find a finned or sashimi fish of the value with base sets of the given type.
+func findFinnedFishOn(grid *core.CandidateGrid, value int, size int, baseType core.HouseType, variant FishVariant) *SolveStep {
+	coverType := getCoverType(baseType)
+
+	baseCandidates := []core.House{}
+	for i := 0; i < 9; i++ {
+		house := core.NewHouse(baseType, i)
+		if len(grid.GetPositionsWithCandidate(house, value)) > 0 {
+			baseCandidates = append(baseCandidates, house)
+		}
+	}
+
+	for _, baseSets := range combinations(baseCandidates, size) {
+		basePositions := []core.Position{}
+		lineIndices := []int{}
+		for _, base := range baseSets {
+			for _, position := range grid.GetPositionsWithCandidate(base, value) {
+				basePositions = append(basePositions, position)
+
+				index := getLineIndex(position, coverType)
+				if !containsInt(lineIndices, index) {
+					lineIndices = append(lineIndices, index)
+				}
+			}
+		}
+
+		// With no more lines than the size, this is a basic fish or not a fish at all.
+		if len(lineIndices) <= size {
+			continue
+		}
+
+		sort.Ints(lineIndices)
+
+		for _, coverIndices := range combinations(lineIndices, size) {
+			if step := checkFinnedFish(grid, value, baseSets, basePositions, coverType, coverIndices, variant); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to check if the base sets and the cover lines make a finned fish of the variant with eliminations.
+func checkFinnedFish(grid *core.CandidateGrid, value int, baseSets []core.House, basePositions []core.Position,
+	coverType core.HouseType, coverIndices []int, variant FishVariant) *SolveStep {
+	// The fins are the base candidates outside the cover sets, they must be in one box.
+	fins := []core.Position{}
+	for _, position := range basePositions {
+		if !containsInt(coverIndices, getLineIndex(position, coverType)) {
+			fins = append(fins, position)
+		}
+	}
+
+	finBox := core.NewBoxHouse(fins[0].GetBox())
+	for _, fin := range fins[1:] {
+		if !finBox.Contains(fin) {
+			return nil
+		}
+	}
+
+	// Every base set needs a body candidate in the cover sets. With only one in any base set, it is a sashimi fish.
+	actualVariant := FinnedFish
+	for _, base := range baseSets {
+		bodyCount := 0
+		for _, position := range basePositions {
+			if base.Contains(position) && containsInt(coverIndices, getLineIndex(position, coverType)) {
+				bodyCount++
+			}
+		}
+
+		if bodyCount == 0 {
+			return nil
+		}
+
+		if bodyCount == 1 {
+			actualVariant = SashimiFish
+		}
+	}
+
+	if actualVariant != variant {
+		return nil
+	}
+
+	// Either a fin is true, or the fish removes the value from the cover sets: only the cells seeing all the fins are safe to clear.
+	coverSets := []core.House{}
+	eliminations := []core.Cell{}
+	for _, index := range coverIndices {
+		cover := core.NewHouse(coverType, index)
+		coverSets = append(coverSets, cover)
+
+		for _, position := range grid.GetPositionsWithCandidate(cover, value) {
+			if finBox.Contains(position) && !isInAnyHouse(baseSets, position) {
+				eliminations = append(eliminations, core.NewCell(position, value))
+			}
+		}
+	}
+
+	if len(eliminations) == 0 {
+		return nil
+	}
+
+	return &SolveStep{
+		Eliminations: eliminations,
+		Pattern:      FishPattern{Value: value, Variant: variant, BaseSets: baseSets, CoverSets: coverSets, Fins: fins},
+	}
+}
+
+// Function to check if an integer is in a list of integers.
+func containsInt(numbers []int, number int) bool {
+	for _, other := range numbers {
+		if other == number {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to find the next fish of the given size and variant, row-based fish first.
+func findFishStep(grid *core.CandidateGrid, size int, variant FishVariant) *SolveStep {
+	for value := 1; value <= 9; value++ {
+		for _, baseType := range []core.HouseType{core.RowHouse, core.ColumnHouse} {
+			var step *SolveStep
+			if variant == BasicFish {
+				step = findFishOn(grid, value, size, baseType)
+			} else {
+				step = findFinnedFishOn(grid, value, size, baseType, variant)
+			}
+
+			if step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// The default maximum number of placements implied by an assumption before giving up on it.
+const DefaultForcingChainDepth = 20
+
+// Define the forcing chain solver object.
+type ForcingChainSolver struct {
+	StrategySolver
+	MaximumDepth int // The maximum number of placements implied by an assumption.
+}
+
+// Constructor like function to create a ForcingChainSolver object following assumptions up to the maximum depth.
+func NewForcingChainSolver(maximumDepth int) ForcingChainSolver {
+	if maximumDepth < 1 {
+		panic("Bug: The maximum forcing chain depth must be at least 1, got " + fmt.Sprint(maximumDepth))
+	}
+
+	return ForcingChainSolver{
+		StrategySolver: newStrategySolver(BaseSolver{
+			Key:         "forcing-chains",
+			DisplayName: "Forcing Chains Solver",
+			Description: fmt.Sprintf(`Strategy solver using singles and forcing chains (nishio) as a last resort: a candidate is removed if placing it leads to a contradiction through at most %d singles.`, maximumDepth),
+			Reliable:    false,
+		}, func(grid *core.CandidateGrid) *SolveStep {
+			return findForcingChainStep(grid, maximumDepth)
+		}),
+		MaximumDepth: maximumDepth,
+	}
+}
+
+// Define the pattern of a forcing chain: an assumption implying placements until a contradiction.
+type ForcingChainPattern struct {
+	Assumption    core.Cell   // The candidate assumed to be placed.
+	Implications  []core.Cell // The placements implied by the assumption through singles, in order.
+	Contradiction string      // The contradiction reached at the end of the chain.
+}
+
+// Function to print the forcing chain pattern.
+func (pattern ForcingChainPattern) ToString() string {
+	result := fmt.Sprintf("assuming %d at %s", pattern.Assumption.Value, pattern.Assumption.Position.ToString())
+	if len(pattern.Implications) > 0 {
+		result += " implies " + formatCells(pattern.Implications)
+	}
+
+	return result + " and then " + pattern.Contradiction
+}
+
+// Function to describe the contradiction of the grid: an empty cell without candidates,
+// or a value that can no longer be placed in a house. Return an empty string if there is none.
+func describeContradiction(grid *core.CandidateGrid) string {
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			if grid.Get(position) == 0 && grid.GetCandidates(position).IsEmpty() {
+				return fmt.Sprintf("%s has no candidate left", position.ToString())
+			}
+		}
+	}
+
+	for _, house := range core.AllHouses() {
+		for value := 1; value <= 9; value++ {
+			if !grid.IsPlacedIn(house, value) && len(grid.GetPositionsWithCandidate(house, value)) == 0 {
+				return fmt.Sprintf("%d has no place left in %s", value, house.ToString())
+			}
+		}
+	}
+
+	return ""
+}
+
+// Function to follow the assumption with singles, up to the maximum depth of implied placements.
+// Return the forcing chain pattern if a contradiction is reached, nil otherwise.
+func followAssumption(grid *core.CandidateGrid, assumption core.Cell, maximumDepth int) *ForcingChainPattern {
+	next := grid.Copy()
+	next.SetCell(assumption)
+
+	implications := []core.Cell{}
+	for {
+		if contradiction := describeContradiction(&next); contradiction != "" {
+			return &ForcingChainPattern{Assumption: assumption, Implications: implications, Contradiction: contradiction}
+		}
+
+		if len(implications) >= maximumDepth {
+			return nil
+		}
+
+		step := findSinglesStep(&next)
+		if step == nil {
+			return nil
+		}
+
+		placement := step.Placements[0]
+		implications = append(implications, placement)
+		next.SetCell(placement)
+	}
+}
+
+// Function to find the shortest forcing chain from a candidate to a contradiction, and remove that candidate.
+func findForcingChainStep(grid *core.CandidateGrid, maximumDepth int) *SolveStep {
+	var best *ForcingChainPattern
+	depth := maximumDepth
+	for row := 0; row < 9 && depth >= 0; row++ {
+		for column := 0; column < 9 && depth >= 0; column++ {
+			position := core.NewPosition(row, column)
+			for _, value := range grid.GetCandidates(position).Digits() {
+				// Only look for chains shorter than the best one so far.
+				if pattern := followAssumption(grid, core.NewCell(position, value), depth); pattern != nil {
+					best = pattern
+					depth = len(pattern.Implications) - 1
+				}
+			}
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	return &SolveStep{
+		Eliminations: []core.Cell{best.Assumption},
+		Pattern:      *best,
+	}
+}
@@ -0,0 +1,132 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the locked candidates solver object.
+type LockedCandidatesSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default LockedCandidatesSolver object.
+func NewLockedCandidatesSolver() LockedCandidatesSolver {
+	return LockedCandidatesSolver{
+		newStrategySolver(BaseSolver{
+			Key:         "locked-candidates",
+			DisplayName: "Locked Candidates Solver",
+			Description: `Strategy solver using singles and intersection removal: pointing pairs/triples and box-line reduction (claiming).`,
+			Reliable:    false,
+		}, findLockedCandidatesStep),
+	}
+}
+
+// Define the pattern of locked candidates.
+type LockedCandidatesPattern struct {
+	Value     int             // The locked value.
+	Claiming  bool            // Box-line reduction if true, otherwise pointing.
+	Source    core.House      // The house where the value is locked into the intersection.
+	Target    core.House      // The house where the value is removed outside of the intersection.
+	Positions []core.Position // The positions of the value in the intersection.
+}
+
+// Function to print the locked candidates pattern.
+func (pattern LockedCandidatesPattern) ToString() string {
+	kind := "pointing"
+	if pattern.Claiming {
+		kind = "claiming"
+	}
+
+	return fmt.Sprintf("%s %d in %s at %s locks it in %s",
+		kind, pattern.Value, pattern.Source.ToString(), formatPositions(pattern.Positions), pattern.Target.ToString())
+}
+
+// Function to find the house of the given type containing all the positions, return nil if there is none.
+func findCommonHouse(positions []core.Position, houseType core.HouseType) *core.House {
+	if len(positions) == 0 {
+		return nil
+	}
+
+	for _, house := range positions[0].GetHouses() {
+		if house.Type != houseType {
+			continue
+		}
+
+		for _, position := range positions[1:] {
+			if !house.Contains(position) {
+				return nil
+			}
+		}
+
+		return &house
+	}
+
+	return nil
+}
+
+// Function to find locked candidates of a value in a source house, eliminating them from a target house type.
+func findLockedCandidatesIn(grid *core.CandidateGrid, source core.House, value int, targetType core.HouseType) *SolveStep {
+	positions := grid.GetPositionsWithCandidate(source, value)
+	if len(positions) < 2 {
+		return nil
+	}
+
+	target := findCommonHouse(positions, targetType)
+	if target == nil {
+		return nil
+	}
+
+	outside := []core.Position{}
+	for _, position := range target.GetPositions() {
+		if !source.Contains(position) {
+			outside = append(outside, position)
+		}
+	}
+
+	eliminations := collectEliminations(grid, outside, value)
+	if len(eliminations) == 0 {
+		return nil
+	}
+
+	return &SolveStep{
+		Eliminations: eliminations,
+		Pattern: LockedCandidatesPattern{
+			Value:     value,
+			Claiming:  source.Type != core.BoxHouse,
+			Source:    source,
+			Target:    *target,
+			Positions: positions,
+		},
+	}
+}
+
+// Function to find the next locked candidates step, pointing first.
+func findLockedCandidatesStep(grid *core.CandidateGrid) *SolveStep {
+	// Pointing: the value in a box is restricted to one row or column.
+	for box := 0; box < 9; box++ {
+		for value := 1; value <= 9; value++ {
+			for _, targetType := range []core.HouseType{core.RowHouse, core.ColumnHouse} {
+				if step := findLockedCandidatesIn(grid, core.NewBoxHouse(box), value, targetType); step != nil {
+					return step
+				}
+			}
+		}
+	}
+
+	// Claiming: the value in a row or column is restricted to one box.
+	for _, house := range core.AllHouses() {
+		if house.Type == core.BoxHouse {
+			continue
+		}
+
+		for value := 1; value <= 9; value++ {
+			if step := findLockedCandidatesIn(grid, house, value, core.BoxHouse); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/gnailuy/sudoku/core"
+	"github.com/gnailuy/sudoku/util"
+)
+
+// The maximum number of branching cells the search tree is split at before counting in parallel.
+const parallelSplitDepth = 4
+
+// The number of parts per worker to split the search tree into, to balance the uneven parts.
+const parallelPartsPerWorker = 8
+
+// Define the parallel solver object, counting the solutions of a reliable solver with a pool of workers.
+// It reports the statistics of the search if the reliable solver is an IInstrumentedSolver.
+type ParallelSolver struct {
+	BaseSolver
+	solver  ISudokuSolver // The reliable solver searching every part of the search tree.
+	workers int           // The number of goroutines counting the parts.
+}
+
+// Constructor like function to create a ParallelSolver object over a reliable solver.
+// A number of workers not positive means one worker per CPU.
+func NewParallelSolver(solver ISudokuSolver, workers int) ParallelSolver {
+	if !solver.IsReliable() {
+		panic("Bug: The parallel solver needs a reliable solver, got " + solver.GetKey())
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return ParallelSolver{
+		BaseSolver: BaseSolver{
+			Key:         solver.GetKey() + "-parallel",
+			DisplayName: solver.GetDisplayName() + " (Parallel)",
+			Description: fmt.Sprintf(`%s Solutions are counted in parallel: the search tree is split at its first branching cells and the parts are counted by a pool of workers.`, solver.GetDescription()),
+			Reliable:    true,
+		},
+		solver:  solver,
+		workers: workers,
+	}
+}
+
+// Function to split the board at its first branching cells into boards with disjoint solutions.
+// At every round, each board is split by the values of its empty cell with the fewest valid values,
+// until there are enough parts or the maximum depth is reached. Boards without solution are dropped.
+func splitSearchTree(board core.SudokuBoard, minimumParts int) []core.SudokuBoard {
+	parts := []core.SudokuBoard{board}
+	for depth := 0; depth < parallelSplitDepth && len(parts) < minimumParts; depth++ {
+		nextParts := []core.SudokuBoard{}
+		for _, part := range parts {
+			position, values := findBranchingCell(&part)
+			if position == nil {
+				// A solved board is a part with one solution.
+				nextParts = append(nextParts, part)
+				continue
+			}
+
+			for _, value := range values {
+				nextPart := part.Copy()
+				nextPart.Set(*position, value)
+				nextParts = append(nextParts, nextPart)
+			}
+		}
+
+		parts = nextParts
+	}
+
+	return parts
+}
+
+// Function to find the empty cell with the fewest valid values and its values, nil if the board is solved.
+func findBranchingCell(board *core.SudokuBoard) (*core.Position, []int) {
+	var best *core.Position
+	var bestValues []int
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			if board.Get(position) != 0 {
+				continue
+			}
+
+			values := []int{}
+			for value := 1; value <= 9; value++ {
+				if board.IsValidInput(position, value) {
+					values = append(values, value)
+				}
+			}
+
+			if best == nil || len(values) < len(bestValues) {
+				best, bestValues = &position, values
+			}
+		}
+	}
+
+	return best, bestValues
+}
+
+// Function to get a copy of the solver whose reliable solver makes its random choices with the source, if it makes any.
+func (solver ParallelSolver) WithRandomSource(source util.IRandomSource) ISudokuSolver {
+	if randomized, ok := solver.solver.(IRandomizedSolver); ok {
+		solver.solver = randomized.WithRandomSource(source)
+	}
+
+	return solver
+}
+
+// Function to solve the Sudoku board with the reliable solver.
+func (solver ParallelSolver) Solve(board *core.SudokuBoard) bool {
+	return solver.solver.Solve(board)
+}
+
+// Function to solve the Sudoku board with the reliable solver, stopping when the context is done.
+func (solver ParallelSolver) SolveContext(ctx context.Context, board *core.SudokuBoard) (bool, error) {
+	return solver.solver.SolveContext(ctx, board)
+}
+
+// Function to solve the Sudoku board with the reliable solver, adding the statistics of its search to the stats if it reports them.
+func (solver ParallelSolver) SolveWithStats(ctx context.Context, board *core.SudokuBoard, stats *SolveStats) (bool, error) {
+	if instrumented, ok := solver.solver.(IInstrumentedSolver); ok {
+		return instrumented.SolveWithStats(ctx, board, stats)
+	}
+
+	return solver.solver.SolveContext(ctx, board)
+}
+
+// Function to give a hint with the reliable solver.
+func (solver ParallelSolver) Hint(board *core.SudokuBoard) *core.Cell {
+	return solver.solver.Hint(board)
+}
+
+// Function to count the number of solutions for the Sudoku board in parallel.
+// Note that if the board is already solved, we return 1 as doing nothing is also a solution.
+func (solver ParallelSolver) CountSolutions(board *core.SudokuBoard) int {
+	return solver.CountSolutionsUpTo(board, 0)
+}
+
+// Function to count the number of solutions for the Sudoku board in parallel, stopping at the limit.
+func (solver ParallelSolver) CountSolutionsUpTo(board *core.SudokuBoard, limit int) int {
+	count, _ := solver.CountSolutionsUpToContext(context.Background(), board, limit)
+	return count
+}
+
+// Function to count the number of solutions for the Sudoku board in parallel, stopping at the limit or when the context is done.
+// The parts of the search tree have disjoint solutions, so the sum of their counts is the count of the serial search.
+func (solver ParallelSolver) CountSolutionsUpToContext(ctx context.Context, board *core.SudokuBoard, limit int) (int, error) {
+	return solver.CountSolutionsWithStats(ctx, board, limit, nil)
+}
+
+// Function to count the solutions of a part of the search tree with the reliable solver,
+// adding the statistics of its search to the stats if it reports them.
+func (solver ParallelSolver) countPart(ctx context.Context, part *core.SudokuBoard, limit int, stats *SolveStats) (int, error) {
+	if instrumented, ok := solver.solver.(IInstrumentedSolver); ok {
+		return instrumented.CountSolutionsWithStats(ctx, part, limit, stats)
+	}
+
+	return solver.solver.CountSolutionsUpToContext(ctx, part, limit)
+}
+
+// Function to count the number of solutions for the Sudoku board in parallel like CountSolutionsUpToContext,
+// adding the statistics of the parts to the stats if not nil. The wall time is the time of the whole parallel count.
+func (solver ParallelSolver) CountSolutionsWithStats(ctx context.Context, board *core.SudokuBoard, limit int, stats *SolveStats) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if board.IsSolved() {
+		return 1, nil
+	}
+
+	if !board.IsValid() {
+		return 0, nil
+	}
+
+	start := time.Now()
+	parts := splitSearchTree(board.Copy(), solver.workers*parallelPartsPerWorker)
+
+	// Cancel the other parts once the limit is reached.
+	countCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan core.SudokuBoard)
+	var mutex sync.Mutex
+	var group sync.WaitGroup
+	numberOfSolutions := 0
+	var countErr error
+	partsStats := SolveStats{}
+
+	for i := 0; i < solver.workers; i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for part := range jobs {
+				partStats := SolveStats{}
+				count, err := solver.countPart(countCtx, &part, limit, &partStats)
+
+				mutex.Lock()
+				numberOfSolutions += count
+				partsStats.Add(partStats)
+				if err != nil && countErr == nil {
+					countErr = err
+				}
+
+				if limit > 0 && numberOfSolutions >= limit {
+					cancel()
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	sent := 0
+	for _, part := range parts {
+		if countCtx.Err() != nil {
+			break
+		}
+
+		jobs <- part
+		sent++
+	}
+
+	close(jobs)
+	group.Wait()
+
+	if stats != nil {
+		partsStats.WallTime = time.Since(start)
+		stats.Add(partsStats)
+	}
+
+	// Reaching the limit cancels the other parts, which is not an error.
+	if limit > 0 && numberOfSolutions >= limit {
+		return limit, nil
+	}
+
+	// Otherwise the parts are only cancelled when the context is done.
+	if countErr == nil && sent < len(parts) {
+		countErr = ctx.Err()
+	}
+
+	return numberOfSolutions, countErr
+}
@@ -0,0 +1,101 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Function to get a board with many solutions, removing all the values up to 4 and the first row of a solved board.
+func newManySolutionsBoard() core.SudokuBoard {
+	board := newBoardFromString("583167294672394815149825376934678521267451983851932467316589742795246138428713659")
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			if position := core.NewPosition(row, column); row == 0 || board.Get(position) <= 4 {
+				board.Unset(position)
+			}
+		}
+	}
+
+	return board
+}
+
+// Test the parallel counting gives the same results as the serial counting.
+func TestParallelSolver(t *testing.T) {
+	many := newManySolutionsBoard()
+	solved := newBoardFromString("583167294672394815149825376934678521267451983851932467316589742795246138428713659")
+	conflicting := newBoardFromString("55" + benchmarkBoards[1][2:])
+	boards := []core.SudokuBoard{many, solved, conflicting}
+	for _, input := range benchmarkBoards {
+		boards = append(boards, newBoardFromString(input))
+	}
+
+	for _, serial := range []ISudokuSolver{NewDefaultSolver(), NewDancingLinksSolver(), NewBitmaskSolver()} {
+		for _, workers := range []int{1, 3, 0} {
+			parallel := NewParallelSolver(serial, workers)
+			if parallel.GetKey() != serial.GetKey()+"-parallel" || !parallel.IsReliable() {
+				t.Errorf("Unexpected parallel solver: %s", parallel.GetKey())
+			}
+
+			for _, board := range boards {
+				for _, limit := range []int{0, 1, 2, 10} {
+					expected := serial.CountSolutionsUpTo(&board, limit)
+					if count := parallel.CountSolutionsUpTo(&board, limit); count != expected {
+						t.Errorf("%s with %d workers: expected %d solutions up to %d, got %d for %s",
+							parallel.GetKey(), workers, expected, limit, count, board.ToString())
+					}
+				}
+			}
+		}
+	}
+
+	if count := NewParallelSolver(NewBitmaskSolver(), 0).CountSolutions(&many); count != 336 {
+		t.Errorf("Expected 336 solutions, got %d", count)
+	}
+
+	// A cancelled context stops the counting.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	empty := core.NewEmptySudokuBoard()
+	if _, err := NewParallelSolver(NewBitmaskSolver(), 0).CountSolutionsUpToContext(ctx, &empty, 0); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the count to be cancelled, got %v", err)
+	}
+}
+
+// Test the parallel solver merges the statistics of its workers.
+func TestParallelSolverStats(t *testing.T) {
+	board := newManySolutionsBoard()
+	parallelStats := SolveStats{}
+	var solver IInstrumentedSolver = NewParallelSolver(NewBitmaskSolver(), 3)
+	if count, err := solver.CountSolutionsWithStats(context.Background(), &board, 0, &parallelStats); count != 336 || err != nil {
+		t.Fatalf("Expected 336 solutions, got %d and %v", count, err)
+	}
+
+	if parallelStats.NodeCount == 0 || parallelStats.Backtracks == 0 || parallelStats.WallTime == 0 {
+		t.Errorf("Unexpected statistics: %s", parallelStats.ToString())
+	}
+
+	solveStats := SolveStats{}
+	if solved, err := solver.SolveWithStats(context.Background(), &board, &solveStats); !solved || err != nil || solveStats.NodeCount == 0 {
+		t.Errorf("Expected the board to be solved with statistics, got %v, %v and %s", solved, err, solveStats.ToString())
+	}
+}
+
+func BenchmarkBitmaskSolverCountManySolutions(b *testing.B) {
+	board := newManySolutionsBoard()
+	solver := NewBitmaskSolver()
+	for i := 0; i < b.N; i++ {
+		solver.CountSolutions(&board)
+	}
+}
+
+func BenchmarkParallelSolverCountManySolutions(b *testing.B) {
+	board := newManySolutionsBoard()
+	solver := NewParallelSolver(NewBitmaskSolver(), 0)
+	for i := 0; i < b.N; i++ {
+		solver.CountSolutions(&board)
+	}
+}
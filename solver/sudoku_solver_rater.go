@@ -0,0 +1,151 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// The score of a board the ladder cannot solve, which needs backtracking.
+const BacktrackingScore = 10.0
+
+// Define the rating of a technique: the key of its strategy solver and its score.
+type TechniqueRating struct {
+	Key   string
+	Score float64
+}
+
+// Function to get the default ladder of technique ratings, similar to the Sudoku Explainer scale.
+func DefaultTechniqueRatings() []TechniqueRating {
+	return []TechniqueRating{
+		{Key: "singles", Score: 1.5},
+		{Key: "locked-candidates", Score: 2.6},
+		{Key: "xwing", Score: 3.2},
+		{Key: "subsets", Score: 3.6},
+		{Key: "swordfish", Score: 3.8},
+		{Key: "skyscraper", Score: 4.0},
+		{Key: "two-string-kite", Score: 4.1},
+		{Key: "wings", Score: 4.2},
+		{Key: "empty-rectangle", Score: 4.3},
+		{Key: "finned-xwing", Score: 4.4},
+		{Key: "sashimi-xwing", Score: 4.4},
+		{Key: "turbot-fish", Score: 4.5},
+		{Key: "uniqueness", Score: 4.6},
+		{Key: "coloring", Score: 4.8},
+		{Key: "finned-swordfish", Score: 4.9},
+		{Key: "sashimi-swordfish", Score: 4.9},
+		{Key: "jellyfish", Score: 5.2},
+		{Key: "finned-jellyfish", Score: 5.4},
+		{Key: "sashimi-jellyfish", Score: 5.4},
+		{Key: "sue-de-coq", Score: 5.6},
+		{Key: "xy-chain", Score: 6.2},
+		{Key: "x-chain", Score: 6.4},
+		{Key: "als", Score: 6.8},
+		{Key: "aic", Score: 7.0},
+		{Key: "forcing-chains", Score: 8.0},
+	}
+}
+
+// Define the rating of a board.
+type SudokuRating struct {
+	Score            float64        // The score of the hardest technique used, or BacktrackingScore if the board is not solved.
+	HardestTechnique string         // The key of the hardest technique used, "default" if the board is not solved.
+	Solved           bool           // If the ladder fully solved the board.
+	TechniqueCounts  map[string]int // The number of steps of each technique.
+}
+
+// Define the rater object, solving boards with a ladder of strategy solvers from the easiest.
+type SudokuRater struct {
+	ratings []TechniqueRating
+	solvers []IStrategySolver
+}
+
+// Constructor like function to create a rater with the default technique ratings.
+func NewSudokuRater(store SudokuSolverStore) SudokuRater {
+	rater, err := NewSudokuRaterWithRatings(store, DefaultTechniqueRatings())
+	if err != nil {
+		panic("Bug: Invalid default technique ratings: " + err.Error())
+	}
+
+	return rater
+}
+
+// Constructor like function to create a rater with custom technique ratings.
+func NewSudokuRaterWithRatings(store SudokuSolverStore, ratings []TechniqueRating) (rater SudokuRater, err error) {
+	if len(ratings) == 0 {
+		return rater, errors.New("no technique ratings")
+	}
+
+	// The easiest techniques are tried first at every step.
+	rater.ratings = append([]TechniqueRating{}, ratings...)
+	sort.SliceStable(rater.ratings, func(i, j int) bool {
+		return rater.ratings[i].Score < rater.ratings[j].Score
+	})
+
+	keys := make([]string, len(rater.ratings))
+	for i, rating := range rater.ratings {
+		keys[i] = rating.Key
+	}
+
+	rater.solvers, err = store.GetStrategySolversByKeys(keys)
+
+	return rater, err
+}
+
+// Function to get a rater limited to the techniques up to the maximum score, faster to reject harder boards.
+// Boards needing a harder technique are rated as not solved.
+func (rater SudokuRater) WithMaximumScore(maximumScore float64) SudokuRater {
+	limited := SudokuRater{}
+	for i, rating := range rater.ratings {
+		if rating.Score <= maximumScore {
+			limited.ratings = append(limited.ratings, rating)
+			limited.solvers = append(limited.solvers, rater.solvers[i])
+		}
+	}
+
+	return limited
+}
+
+// Function to get the score of a technique key, 0 if it is not in the ladder.
+func (rater SudokuRater) GetScore(key string) float64 {
+	for _, rating := range rater.ratings {
+		if rating.Key == key {
+			return rating.Score
+		}
+	}
+
+	return 0
+}
+
+// Function to rate the board: the score is the one of the hardest technique needed when always applying the easiest step.
+func (rater SudokuRater) Rate(board *core.SudokuBoard) SudokuRating {
+	rating, _ := rater.RateContext(context.Background(), board)
+	return rating
+}
+
+// Function to rate the board like Rate, stopping with an error when the context is done.
+func (rater SudokuRater) RateContext(ctx context.Context, board *core.SudokuBoard) (SudokuRating, error) {
+	trace, err := TraceSolveContext(ctx, board, rater.solvers)
+	if err != nil {
+		return SudokuRating{}, err
+	}
+
+	rating := SudokuRating{Solved: trace.Solved, TechniqueCounts: map[string]int{}}
+	for _, step := range trace.Steps {
+		rating.TechniqueCounts[step.Technique]++
+
+		if score := rater.GetScore(step.Technique); score > rating.Score {
+			rating.Score = score
+			rating.HardestTechnique = step.Technique
+		}
+	}
+
+	if !trace.Solved {
+		rating.Score = BacktrackingScore
+		rating.HardestTechnique = "default"
+	}
+
+	return rating, nil
+}
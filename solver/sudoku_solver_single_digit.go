@@ -0,0 +1,252 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the single digit pattern types based on strong links.
+type SingleDigitPatternType int
+
+const (
+	Skyscraper SingleDigitPatternType = iota
+	TwoStringKite
+	TurbotFish
+	EmptyRectangle
+)
+
+// Names and keys of the single digit pattern types.
+var singleDigitPatternNames = map[SingleDigitPatternType]string{
+	Skyscraper:     "Skyscraper",
+	TwoStringKite:  "2-String Kite",
+	TurbotFish:     "Turbot Fish",
+	EmptyRectangle: "Empty Rectangle",
+}
+var singleDigitPatternKeys = map[SingleDigitPatternType]string{
+	Skyscraper:     "skyscraper",
+	TwoStringKite:  "two-string-kite",
+	TurbotFish:     "turbot-fish",
+	EmptyRectangle: "empty-rectangle",
+}
+
+// Define the single digit solver object.
+type SingleDigitSolver struct {
+	StrategySolver
+	PatternType SingleDigitPatternType
+}
+
+// Constructor like function to create a Skyscraper solver.
+func NewSkyscraperSolver() SingleDigitSolver {
+	return newSingleDigitSolver(Skyscraper,
+		`Strategy solver using singles and the Skyscraper: two parallel strong links on a value joined at one end by a common line.`)
+}
+
+// Constructor like function to create a 2-String Kite solver.
+func NewTwoStringKiteSolver() SingleDigitSolver {
+	return newSingleDigitSolver(TwoStringKite,
+		`Strategy solver using singles and the 2-String Kite: a row and a column strong link on a value joined at one end in a box.`)
+}
+
+// Constructor like function to create a Turbot Fish solver.
+func NewTurbotFishSolver() SingleDigitSolver {
+	return newSingleDigitSolver(TurbotFish,
+		`Strategy solver using singles and the Turbot Fish: two strong links on a value joined by a weak link, other than a Skyscraper or a 2-String Kite.`)
+}
+
+// Constructor like function to create an Empty Rectangle solver.
+func NewEmptyRectangleSolver() SingleDigitSolver {
+	return newSingleDigitSolver(EmptyRectangle,
+		`Strategy solver using singles and the Empty Rectangle: a value confined to one row and one column of a box, combined with a strong link.`)
+}
+
+func newSingleDigitSolver(patternType SingleDigitPatternType, description string) SingleDigitSolver {
+	return SingleDigitSolver{
+		StrategySolver: newStrategySolver(BaseSolver{
+			Key:         singleDigitPatternKeys[patternType],
+			DisplayName: singleDigitPatternNames[patternType] + " Solver",
+			Description: description,
+			Reliable:    false,
+		}, func(grid *core.CandidateGrid) *SolveStep {
+			return findSingleDigitStep(grid, patternType)
+		}),
+		PatternType: patternType,
+	}
+}
+
+// Define the pattern of a single digit technique.
+type SingleDigitPattern struct {
+	Type  SingleDigitPatternType // The type of the pattern.
+	Value int                    // The value of the pattern.
+	Links []StrongLink           // The strong links of the pattern, in chain order.
+	Box   *core.House            // The box of an Empty Rectangle, nil otherwise.
+}
+
+// Function to print the single digit pattern.
+func (pattern SingleDigitPattern) ToString() string {
+	result := fmt.Sprintf("%s on %d", singleDigitPatternNames[pattern.Type], pattern.Value)
+	if pattern.Box != nil {
+		result += " in " + pattern.Box.ToString()
+	}
+
+	for i, link := range pattern.Links {
+		if i == 0 {
+			result += " with "
+		} else {
+			result += " and "
+		}
+		result += link.ToString()
+	}
+
+	return result
+}
+
+// Function to classify a chain of two strong links A = B - C = D joined by the weak link B - C.
+// Return false when the free ends of two parallel links are also aligned, which is an X-Wing left to the fish solvers.
+func classifyTwoLinkChain(first StrongLink, second StrongLink) (SingleDigitPatternType, bool) {
+	a, b, c, d := first.Ends[0], first.Ends[1], second.Ends[0], second.Ends[1]
+
+	if first.House.Type == core.RowHouse && second.House.Type == core.RowHouse && b.Column == c.Column {
+		return Skyscraper, a.Column != d.Column
+	}
+
+	if first.House.Type == core.ColumnHouse && second.House.Type == core.ColumnHouse && b.Row == c.Row {
+		return Skyscraper, a.Row != d.Row
+	}
+
+	if first.House.Type != core.BoxHouse && second.House.Type != core.BoxHouse &&
+		first.House.Type != second.House.Type && b.GetBox() == c.GetBox() {
+		return TwoStringKite, true
+	}
+
+	return TurbotFish, true
+}
+
+// Function to find a Skyscraper, 2-String Kite or Turbot Fish:
+// in a chain A = B - C = D, either A or D is the value, so it can be removed from the cells seeing both.
+func findTwoLinkChain(grid *core.CandidateGrid, value int, patternType SingleDigitPatternType) *SolveStep {
+	links := findStrongLinks(grid, value)
+
+	for _, pair := range combinations(links, 2) {
+		for _, first := range []StrongLink{pair[0], reverseLink(pair[0])} {
+			for _, second := range []StrongLink{pair[1], reverseLink(pair[1])} {
+				a, b, c, d := first.Ends[0], first.Ends[1], second.Ends[0], second.Ends[1]
+
+				// The four positions must be different and B must see C.
+				if a == c || a == d || b == c || b == d || !b.IsPeerOf(c) {
+					continue
+				}
+
+				if chainType, ok := classifyTwoLinkChain(first, second); !ok || chainType != patternType {
+					continue
+				}
+
+				eliminations := collectEliminations(grid, getCommonPeers(grid, a, d), value)
+				if len(eliminations) > 0 {
+					return &SolveStep{
+						Eliminations: eliminations,
+						Pattern:      SingleDigitPattern{Type: patternType, Value: value, Links: []StrongLink{first, second}},
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to swap the ends of a strong link.
+func reverseLink(link StrongLink) StrongLink {
+	return StrongLink{House: link.House, Ends: [2]core.Position{link.Ends[1], link.Ends[0]}}
+}
+
+// Function to find the rows and columns of a box covering all the candidates of the value in the box,
+// return none if the candidates are all in one line. Two candidates off a common line have two crosses.
+func findEmptyRectangleCrosses(positions []core.Position, box core.House) [][2]int {
+	crosses := [][2]int{}
+	if len(positions) < 2 || findCommonHouse(positions, core.RowHouse) != nil || findCommonHouse(positions, core.ColumnHouse) != nil {
+		return crosses
+	}
+
+	startRow, startColumn := box.Index/3*3, box.Index%3*3
+	for row := startRow; row < startRow+3; row++ {
+		for column := startColumn; column < startColumn+3; column++ {
+			covered := true
+			for _, position := range positions {
+				if position.Row != row && position.Column != column {
+					covered = false
+					break
+				}
+			}
+
+			if covered {
+				crosses = append(crosses, [2]int{row, column})
+			}
+		}
+	}
+
+	return crosses
+}
+
+// Function to find an Empty Rectangle: the value in a box is confined to a row and a column (the cross).
+// With a strong link P = Q on a line outside the box where Q is on the cross, the value can be removed
+// from the cell on the other line of the cross seeing P, as it would empty the box.
+func findEmptyRectangle(grid *core.CandidateGrid, value int) *SolveStep {
+	links := findStrongLinks(grid, value)
+
+	for box := 0; box < 9; box++ {
+		boxHouse := core.NewBoxHouse(box)
+		for _, cross := range findEmptyRectangleCrosses(grid.GetPositionsWithCandidate(boxHouse, value), boxHouse) {
+			row, column := cross[0], cross[1]
+
+			for _, link := range links {
+				if link.House.Type == core.BoxHouse {
+					continue
+				}
+
+				for _, oriented := range []StrongLink{link, reverseLink(link)} {
+					p, q := oriented.Ends[0], oriented.Ends[1]
+					if p.GetBox()/3 == box/3 || p.GetBox()%3 == box%3 || boxHouse.Contains(q) {
+						continue
+					}
+
+					var target core.Position
+					if link.House.Type == core.ColumnHouse && q.Row == row {
+						target = core.NewPosition(p.Row, column)
+					} else if link.House.Type == core.RowHouse && q.Column == column {
+						target = core.NewPosition(row, p.Column)
+					} else {
+						continue
+					}
+
+					if grid.HasCandidate(target, value) {
+						return &SolveStep{
+							Eliminations: []core.Cell{core.NewCell(target, value)},
+							Pattern:      SingleDigitPattern{Type: EmptyRectangle, Value: value, Links: []StrongLink{oriented}, Box: &boxHouse},
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find the next single digit step of the given type.
+func findSingleDigitStep(grid *core.CandidateGrid, patternType SingleDigitPatternType) *SolveStep {
+	for value := 1; value <= 9; value++ {
+		var step *SolveStep
+		if patternType == EmptyRectangle {
+			step = findEmptyRectangle(grid, value)
+		} else {
+			step = findTwoLinkChain(grid, value, patternType)
+		}
+
+		if step != nil {
+			return step
+		}
+	}
+
+	return nil
+}
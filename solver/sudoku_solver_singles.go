@@ -0,0 +1,87 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+const singlesSolverKey = "singles"
+
+// Define the singles solver object.
+type SinglesSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default SinglesSolver object.
+func NewSinglesSolver() SinglesSolver {
+	return SinglesSolver{
+		newStrategySolver(BaseSolver{
+			Key:         singlesSolverKey,
+			DisplayName: "Singles Solver",
+			Description: `Strategy solver using only naked singles and hidden singles, never guessing.`,
+			Reliable:    false,
+		}, findSinglesStep),
+	}
+}
+
+// Define the pattern of a single.
+type SinglePattern struct {
+	Hidden bool       // A hidden single if true, otherwise a naked single.
+	House  core.House // The house of a hidden single.
+}
+
+// Function to print the single pattern.
+func (pattern SinglePattern) ToString() string {
+	if pattern.Hidden {
+		return fmt.Sprintf("hidden single in %s", pattern.House.ToString())
+	}
+
+	return "naked single"
+}
+
+// Function to find a naked single: an empty cell with only one candidate.
+func findNakedSingle(grid *core.CandidateGrid) *SolveStep {
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			candidates := grid.GetCandidates(position)
+
+			if candidates.Count() == 1 {
+				return &SolveStep{
+					Placements: []core.Cell{core.NewCell(position, candidates.First())},
+					Pattern:    SinglePattern{Hidden: false},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find a hidden single: a value that fits in only one position of a house.
+func findHiddenSingle(grid *core.CandidateGrid) *SolveStep {
+	for _, house := range core.AllHouses() {
+		for value := 1; value <= 9; value++ {
+			positions := grid.GetPositionsWithCandidate(house, value)
+
+			if len(positions) == 1 {
+				return &SolveStep{
+					Placements: []core.Cell{core.NewCell(positions[0], value)},
+					Pattern:    SinglePattern{Hidden: true, House: house},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find the next single, naked singles first.
+func findSinglesStep(grid *core.CandidateGrid) *SolveStep {
+	if step := findNakedSingle(grid); step != nil {
+		return step
+	}
+
+	return findHiddenSingle(grid)
+}
@@ -0,0 +1,72 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the statistics of the search of a solver.
+type SolveStats struct {
+	NodeCount    int           // The number of search nodes visited.
+	Backtracks   int           // The number of guesses undone to try another one.
+	MaxDepth     int           // The maximum number of guesses on the search path.
+	Propagations int           // The number of values placed by propagation instead of guessing.
+	WallTime     time.Duration // The time spent searching.
+}
+
+// Function to add other statistics, keeping the largest maximum depth, to collect the statistics of several searches.
+func (stats *SolveStats) Add(other SolveStats) {
+	stats.NodeCount += other.NodeCount
+	stats.Backtracks += other.Backtracks
+	stats.MaxDepth = max(stats.MaxDepth, other.MaxDepth)
+	stats.Propagations += other.Propagations
+	stats.WallTime += other.WallTime
+}
+
+// Function to print the statistics.
+func (stats SolveStats) ToString() string {
+	return fmt.Sprintf("%d nodes, %d backtracks, maximum depth %d, %d propagations in %s",
+		stats.NodeCount, stats.Backtracks, stats.MaxDepth, stats.Propagations, stats.WallTime)
+}
+
+// Define the interface of a solver reporting the statistics of its search to an optional sink.
+type IInstrumentedSolver interface {
+	ISudokuSolver
+
+	// Solve the Sudoku board like SolveContext, adding the statistics of the search to the stats if not nil.
+	SolveWithStats(ctx context.Context, board *core.SudokuBoard, stats *SolveStats) (bool, error)
+
+	// Count the number of solutions of the board like CountSolutionsUpToContext, adding the statistics of the search to the stats if not nil.
+	CountSolutionsWithStats(ctx context.Context, board *core.SudokuBoard, limit int, stats *SolveStats) (int, error)
+}
+
+// Define the recorder of the statistics of a search.
+type statsRecorder struct {
+	stats SolveStats // The statistics of the search so far, except the wall time.
+	start time.Time  // The start time of the search.
+}
+
+// Constructor like function to create a statsRecorder object, starting the clock.
+func newStatsRecorder() statsRecorder {
+	return statsRecorder{start: time.Now()}
+}
+
+// Function to record a visit of a search node at the depth.
+func (recorder *statsRecorder) visit(depth int) {
+	recorder.stats.NodeCount++
+	recorder.stats.MaxDepth = max(recorder.stats.MaxDepth, depth)
+}
+
+// Function to add the statistics of the search to the sink, if any.
+func (recorder *statsRecorder) report(sink *SolveStats) {
+	if sink == nil {
+		return
+	}
+
+	stats := recorder.stats
+	stats.WallTime = time.Since(recorder.start)
+	sink.Add(stats)
+}
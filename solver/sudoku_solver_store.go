@@ -1,31 +1,94 @@
 package solver
 
+import "errors"
+
+// The key of the solver used as the default solver of a new store.
+const defaultSolverKey = "default"
+
 // Define the solver store type containing the list of solvers.
-type SudokuSolverStore map[string]ISudokuSolver
+// It is no longer a map of the solvers by key: the default solver is selected by a key kept beside the solvers.
+type SudokuSolverStore struct {
+	solvers    map[string]ISudokuSolver
+	defaultKey string // The key of the reliable solver returned by GetDefaultSolver.
+}
 
 // Function to initialize the solver store.
 func NewSudokuSolverStore() SudokuSolverStore {
-	store := make(SudokuSolverStore)
+	store := SudokuSolverStore{
+		solvers:    make(map[string]ISudokuSolver),
+		defaultKey: defaultSolverKey,
+	}
+
+	// Register the reliable solvers.
+	store.register(NewDefaultSolver())
+	store.register(NewDancingLinksSolver())
+	store.register(NewBitmaskSolver())
+	store.register(NewParallelSolver(NewBitmaskSolver(), 0))
 
-	// Register the default solver.
-	defaultSolver := NewDefaultSolver()
-	store[defaultSolver.GetKey()] = defaultSolver
+	// Register the strategy solvers.
+	store.register(NewSinglesSolver())
+	store.register(NewLockedCandidatesSolver())
+	store.register(NewSubsetsSolver())
+	for size := 2; size <= 4; size++ {
+		store.register(NewFishSolver(size))
+		store.register(NewFinnedFishSolver(size))
+		store.register(NewSashimiFishSolver(size))
+	}
+	store.register(NewWingsSolver())
+	store.register(NewSkyscraperSolver())
+	store.register(NewTwoStringKiteSolver())
+	store.register(NewTurbotFishSolver())
+	store.register(NewEmptyRectangleSolver())
+	store.register(NewColoringSolver())
+	store.register(NewChainSolver(XChain, DefaultMaximumChainLength))
+	store.register(NewChainSolver(XYChain, DefaultMaximumChainLength))
+	store.register(NewChainSolver(AlternatingInference, DefaultMaximumChainLength))
+	store.register(NewUniquenessSolver())
+	store.register(NewAlmostLockedSetsSolver())
+	store.register(NewSueDeCoqSolver())
+	store.register(NewForcingChainSolver(DefaultForcingChainDepth))
 
 	return store
 }
 
+// Function to register a solver to the store by its key.
+func (store SudokuSolverStore) register(solver ISudokuSolver) {
+	if _, ok := store.solvers[solver.GetKey()]; ok {
+		panic("Bug: Duplicated solver key: " + solver.GetKey())
+	}
+
+	store.solvers[solver.GetKey()] = solver
+}
+
 // Function to get the solver by key from the store.
 func (store SudokuSolverStore) GetSolverByKey(key string) ISudokuSolver {
-	if solver, ok := store[key]; ok {
+	if solver, ok := store.solvers[key]; ok {
 		return solver
 	}
 
 	return nil
 }
 
-// Function to get the default backtracking solver from the store.
+// Function to select the default solver of the store by key, the solver must be reliable.
+// Select it before passing the store to a generator or a game, as they keep their own copy of the store.
+func (store *SudokuSolverStore) SetDefaultSolver(key string) error {
+	solver := store.GetSolverByKey(key)
+	if solver == nil {
+		return errors.New("unknown solver key: " + key)
+	}
+
+	if !solver.IsReliable() {
+		return errors.New("the default solver must be reliable: " + key)
+	}
+
+	store.defaultKey = key
+
+	return nil
+}
+
+// Function to get the default reliable solver from the store.
 func (store SudokuSolverStore) GetDefaultSolver() ISudokuSolver {
-	defaultSolver := store.GetSolverByKey("default")
+	defaultSolver := store.GetSolverByKey(store.defaultKey)
 
 	if defaultSolver == nil {
 		panic("Bug: Default solver not found in the store")
@@ -37,3 +100,18 @@ func (store SudokuSolverStore) GetDefaultSolver() ISudokuSolver {
 
 	return defaultSolver
 }
+
+// Function to get the strategy solvers by keys from the store, in the order of the keys.
+func (store SudokuSolverStore) GetStrategySolversByKeys(keys []string) ([]IStrategySolver, error) {
+	strategySolvers := []IStrategySolver{}
+	for _, key := range keys {
+		strategySolver, ok := store.GetSolverByKey(key).(IStrategySolver)
+		if !ok {
+			return nil, errors.New("not a strategy solver key: " + key)
+		}
+
+		strategySolvers = append(strategySolvers, strategySolver)
+	}
+
+	return strategySolvers, nil
+}
@@ -0,0 +1,34 @@
+package solver
+
+import "testing"
+
+// Test the selection of the default solver of the store.
+func TestSetDefaultSolver(t *testing.T) {
+	store := NewSudokuSolverStore()
+	if store.GetDefaultSolver().GetKey() != "default" {
+		t.Errorf("Unexpected default solver: %s", store.GetDefaultSolver().GetKey())
+	}
+
+	if err := store.SetDefaultSolver("singles"); err == nil {
+		t.Error("Expected an error for an unreliable default solver")
+	}
+
+	if err := store.SetDefaultSolver("unknown"); err == nil {
+		t.Error("Expected an error for an unknown solver key")
+	}
+
+	// A copy of the store keeps its own default solver.
+	copied := store
+	if err := store.SetDefaultSolver("dlx"); err != nil || store.GetDefaultSolver().GetKey() != "dlx" {
+		t.Errorf("Expected the dancing links solver as the default solver, got %v", err)
+	}
+
+	if copied.GetDefaultSolver().GetKey() != "default" {
+		t.Errorf("Expected the copy to keep the default solver, got %s", copied.GetDefaultSolver().GetKey())
+	}
+
+	// The default solver is only registered under its own key.
+	if store.GetSolverByKey("") != nil {
+		t.Error("Expected no solver with an empty key")
+	}
+}
@@ -0,0 +1,370 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the interface of a pattern a step is based on, used to explain the step to a player.
+type IStepPattern interface {
+	// Return a user facing description of the pattern.
+	ToString() string
+}
+
+// Define a single logical step found by a strategy.
+type SolveStep struct {
+	Technique    string       // The key of the strategy solver that found the step.
+	Placements   []core.Cell  // Values placed by the step.
+	Eliminations []core.Cell  // Candidates removed by the step, the value of each cell is the removed candidate.
+	Pattern      IStepPattern // The pattern the step is based on.
+}
+
+// Function to apply the step to a candidate grid.
+func (step *SolveStep) Apply(grid *core.CandidateGrid) {
+	for _, elimination := range step.Eliminations {
+		grid.Eliminate(elimination.Position, elimination.Value)
+	}
+
+	for _, placement := range step.Placements {
+		grid.SetCell(placement)
+	}
+}
+
+// Function to print the step as a user facing explanation.
+func (step *SolveStep) ToString() string {
+	result := step.Technique
+	if step.Pattern != nil {
+		result += ": " + step.Pattern.ToString()
+	}
+
+	if len(step.Placements) > 0 {
+		result += "; place " + formatCells(step.Placements)
+	}
+
+	if len(step.Eliminations) > 0 {
+		result += "; remove " + formatCells(step.Eliminations)
+	}
+
+	return result
+}
+
+// Function to format a list of cells as a user facing string.
+func formatCells(cells []core.Cell) string {
+	parts := make([]string, len(cells))
+	for i, cell := range cells {
+		parts[i] = fmt.Sprintf("%d at %s", cell.Value, cell.Position.ToString())
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Function to format a list of positions as a user facing string.
+func formatPositions(positions []core.Position) string {
+	parts := make([]string, len(positions))
+	for i, position := range positions {
+		parts[i] = position.ToString()
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Function to collect the eliminations of a value from the positions where it is still a candidate.
+func collectEliminations(grid *core.CandidateGrid, positions []core.Position, value int) []core.Cell {
+	eliminations := []core.Cell{}
+	for _, position := range positions {
+		if grid.HasCandidate(position, value) {
+			eliminations = append(eliminations, core.NewCell(position, value))
+		}
+	}
+
+	return eliminations
+}
+
+// Function to check if a position is in a list of positions.
+func containsPosition(positions []core.Position, position core.Position) bool {
+	for _, other := range positions {
+		if other == position {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Function to get the empty positions seeing all the given positions.
+func getCommonPeers(grid *core.CandidateGrid, positions ...core.Position) []core.Position {
+	commonPeers := []core.Position{}
+	for _, peer := range positions[0].GetPeers() {
+		if grid.Get(peer) != 0 {
+			continue
+		}
+
+		seesAll := true
+		for _, position := range positions[1:] {
+			if !position.IsPeerOf(peer) {
+				seesAll = false
+				break
+			}
+		}
+
+		if seesAll {
+			commonPeers = append(commonPeers, peer)
+		}
+	}
+
+	return commonPeers
+}
+
+// Define a strong link: a house where a value has exactly two candidate positions, so one of them must be the value.
+type StrongLink struct {
+	House core.House
+	Ends  [2]core.Position
+}
+
+// Function to print the strong link.
+func (link StrongLink) ToString() string {
+	return fmt.Sprintf("%s = %s in %s", link.Ends[0].ToString(), link.Ends[1].ToString(), link.House.ToString())
+}
+
+// Function to find all the strong links of a value.
+// A pair of positions sharing a line and a box is only reported once, on the line.
+func findStrongLinks(grid *core.CandidateGrid, value int) []StrongLink {
+	links := []StrongLink{}
+	for _, house := range core.AllHouses() {
+		positions := grid.GetPositionsWithCandidate(house, value)
+		if len(positions) != 2 {
+			continue
+		}
+
+		duplicated := false
+		for _, link := range links {
+			if link.Ends[0] == positions[0] && link.Ends[1] == positions[1] {
+				duplicated = true
+				break
+			}
+		}
+
+		if !duplicated {
+			links = append(links, StrongLink{House: house, Ends: [2]core.Position{positions[0], positions[1]}})
+		}
+	}
+
+	return links
+}
+
+// Define the interface of a strategy solver that solves the board step by step like a human player.
+type IStrategySolver interface {
+	ISudokuSolver
+
+	// Find the next step using only the strategy of the solver, return nil if the strategy does not apply.
+	// If the strategy requires uniqueness, the caller must make sure the grid has a unique solution.
+	FindStep(grid *core.CandidateGrid) *SolveStep
+
+	// Return if the strategy is only valid on boards with a unique solution.
+	RequiresUniqueness() bool
+
+	// Give the steps leading to the next placement of the board, return nil if the solver cannot find one.
+	ExplainHint(board *core.SudokuBoard) []SolveStep
+
+	// Give the steps leading to the next placement of the board like ExplainHint, stopping with an error when the context is done.
+	ExplainHintContext(ctx context.Context, board *core.SudokuBoard) ([]SolveStep, error)
+}
+
+// Define the base of the strategy solvers: singles first, then the strategy of the solver.
+type StrategySolver struct {
+	BaseSolver
+	findStep           func(grid *core.CandidateGrid) *SolveStep // The strategy of the solver.
+	requiresUniqueness bool                                      // The strategy is only valid on boards with a unique solution.
+}
+
+// Constructor like function to create a strategy solver from a step finder.
+func newStrategySolver(base BaseSolver, findStep func(grid *core.CandidateGrid) *SolveStep) StrategySolver {
+	if base.Reliable {
+		panic("Bug: Strategy solvers cannot be reliable")
+	}
+
+	return StrategySolver{
+		BaseSolver: base,
+		findStep:   findStep,
+	}
+}
+
+// Function to check if the strategy of the solver is only valid on boards with a unique solution.
+func (solver StrategySolver) RequiresUniqueness() bool {
+	return solver.requiresUniqueness
+}
+
+// Function to check if the solver can work on the grid: strategies based on uniqueness need a unique solution.
+// Counting the solutions stops with an error when the context is done.
+func (solver StrategySolver) canSolve(ctx context.Context, grid *core.CandidateGrid) (bool, error) {
+	if !solver.requiresUniqueness {
+		return true, nil
+	}
+
+	count, err := countGridSolutions(ctx, grid.Copy(), 2)
+	return err == nil && count == 1, err
+}
+
+// Function to find the next step using only the strategy of the solver.
+func (solver StrategySolver) FindStep(grid *core.CandidateGrid) *SolveStep {
+	step := solver.findStep(grid)
+	if step != nil {
+		step.Technique = solver.Key
+	}
+
+	return step
+}
+
+// Function to find the next step, trying the singles before the strategy of the solver.
+func (solver StrategySolver) nextStep(grid *core.CandidateGrid) *SolveStep {
+	if step := findSinglesStep(grid); step != nil {
+		step.Technique = singlesSolverKey
+		return step
+	}
+
+	return solver.FindStep(grid)
+}
+
+// Function to solve the Sudoku board step by step.
+// The board is left untouched if it cannot be fully solved.
+func (solver StrategySolver) Solve(board *core.SudokuBoard) bool {
+	solved, _ := solver.SolveContext(context.Background(), board)
+	return solved
+}
+
+// Function to solve the Sudoku board step by step, checking the context before every step.
+func (solver StrategySolver) SolveContext(ctx context.Context, board *core.SudokuBoard) (bool, error) {
+	if !board.IsValid() {
+		return false, ctx.Err()
+	}
+
+	grid := core.NewCandidateGrid(*board)
+	if ok, err := solver.canSolve(ctx, &grid); !ok {
+		return false, err
+	}
+
+	for !grid.IsSolved() {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		step := solver.nextStep(&grid)
+		if step == nil {
+			return false, nil
+		}
+
+		step.Apply(&grid)
+	}
+
+	board.Merge(grid.GetBoard())
+
+	return true, nil
+}
+
+// Function to give the steps leading to the next placement of the board.
+func (solver StrategySolver) ExplainHint(board *core.SudokuBoard) []SolveStep {
+	steps, _ := solver.ExplainHintContext(context.Background(), board)
+	return steps
+}
+
+// Function to give the steps leading to the next placement of the board, checking the context before every step.
+func (solver StrategySolver) ExplainHintContext(ctx context.Context, board *core.SudokuBoard) ([]SolveStep, error) {
+	if !board.IsValid() || board.IsSolved() {
+		return nil, ctx.Err()
+	}
+
+	steps := []SolveStep{}
+	grid := core.NewCandidateGrid(*board)
+	if ok, err := solver.canSolve(ctx, &grid); !ok {
+		return nil, err
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		step := solver.nextStep(&grid)
+		if step == nil {
+			return nil, nil
+		}
+
+		steps = append(steps, *step)
+		if len(step.Placements) > 0 {
+			return steps, nil
+		}
+
+		step.Apply(&grid)
+	}
+}
+
+// Function to give the next placement as a hint.
+func (solver StrategySolver) Hint(board *core.SudokuBoard) *core.Cell {
+	steps := solver.ExplainHint(board)
+	if len(steps) == 0 {
+		return nil
+	}
+
+	return &steps[len(steps)-1].Placements[0]
+}
+
+// Function to count the number of solutions for the Sudoku board.
+// A board solved step by step without guessing has exactly one solution, otherwise we cannot tell and return 0.
+func (solver StrategySolver) CountSolutions(board *core.SudokuBoard) int {
+	count, _ := solver.CountSolutionsUpToContext(context.Background(), board, 0)
+	return count
+}
+
+// Function to count the number of solutions for the Sudoku board up to the limit.
+// Strategy solvers never find more than one solution, so the limit does not matter.
+func (solver StrategySolver) CountSolutionsUpTo(board *core.SudokuBoard, limit int) int {
+	return solver.CountSolutions(board)
+}
+
+// Function to count the number of solutions for the Sudoku board up to the limit, checking the context before every step.
+func (solver StrategySolver) CountSolutionsUpToContext(ctx context.Context, board *core.SudokuBoard, limit int) (int, error) {
+	boardCopy := board.Copy()
+	if solved, err := solver.SolveContext(ctx, &boardCopy); !solved {
+		return 0, err
+	}
+
+	return 1, nil
+}
+
+// Function to generate all the combinations of k items, keeping the order of the items.
+func combinations[T any](items []T, k int) [][]T {
+	result := [][]T{}
+	if k <= 0 || k > len(items) {
+		return result
+	}
+
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	for {
+		combination := make([]T, k)
+		for i, index := range indices {
+			combination[i] = items[index]
+		}
+		result = append(result, combination)
+
+		// Advance the rightmost index that can still move forward.
+		i := k - 1
+		for i >= 0 && indices[i] == len(items)-k+i {
+			i--
+		}
+		if i < 0 {
+			return result
+		}
+
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
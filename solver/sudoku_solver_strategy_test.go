@@ -0,0 +1,952 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Function to create a board from a string for the tests.
+func newBoardFromString(s string) core.SudokuBoard {
+	board := core.NewEmptySudokuBoard()
+	board.FromString(s)
+
+	return board
+}
+
+// Test the singles solver on a board solvable with singles only.
+func TestSinglesSolver(t *testing.T) {
+	solver := NewSinglesSolver()
+	board := newBoardFromString("583.67..46723.48...4.8253.6934..852.2.74519.3851.3.4673..589742.952461.84.87..659")
+
+	hint := solver.Hint(&board)
+	if hint == nil {
+		t.Fatal("Expected a hint from the singles solver")
+	}
+
+	if !solver.Solve(&board) {
+		t.Fatal("Expected the singles solver to solve the board")
+	}
+
+	if board.ToString() != "583167294672394815149825376934678521267451983851932467316589742795246138428713659" {
+		t.Errorf("Unexpected solution: %s", board.ToString())
+	}
+
+	if board.Get(hint.Position) != hint.Value {
+		t.Errorf("Expected the hint %s to match the solution", hint.ToString())
+	}
+
+	if solver.CountSolutions(&board) != 1 {
+		t.Errorf("Expected 1 solution for a solved board, got %d", solver.CountSolutions(&board))
+	}
+}
+
+// Test the locked candidates solver with a pointing pattern.
+func TestLockedCandidatesStep(t *testing.T) {
+	solver := NewLockedCandidatesSolver()
+
+	// Fill the rows 2 and 3 of the first box, so the 1 in the box is locked in the row 1.
+	board := newBoardFromString("........." + "234......" + "567......" + strings.Repeat(".", 54))
+	grid := core.NewCandidateGrid(board)
+
+	step := solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a locked candidates step")
+	}
+
+	pattern, ok := step.Pattern.(LockedCandidatesPattern)
+	if !ok || pattern.Claiming || pattern.Source != core.NewBoxHouse(0) || pattern.Target != core.NewRowHouse(0) {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	if len(step.Eliminations) != 6 {
+		t.Errorf("Expected 6 eliminations, got %d", len(step.Eliminations))
+	}
+
+	for _, elimination := range step.Eliminations {
+		if elimination.Position.Row != 0 || elimination.Position.Column < 3 || elimination.Value != pattern.Value {
+			t.Errorf("Unexpected elimination: %s", elimination.ToString())
+		}
+	}
+}
+
+// Test the subsets solver with a naked pair.
+func TestSubsetsStep(t *testing.T) {
+	solver := NewSubsetsSolver()
+
+	// The first two cells of the row 1 can only be 1 or 2, which forms a naked pair in the first box.
+	board := newBoardFromString("..3456789" + strings.Repeat(".", 72))
+	grid := core.NewCandidateGrid(board)
+
+	step := solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a subsets step")
+	}
+
+	pattern, ok := step.Pattern.(SubsetPattern)
+	if !ok || pattern.Hidden || pattern.House != core.NewBoxHouse(0) || pattern.Values != core.NewCandidateSet(1, 2) {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	if len(step.Eliminations) != 12 {
+		t.Errorf("Expected 12 eliminations, got %d", len(step.Eliminations))
+	}
+}
+
+// Test the combinations function.
+func TestCombinations(t *testing.T) {
+	result := combinations([]int{1, 2, 3, 4}, 2)
+	if len(result) != 6 {
+		t.Errorf("Expected 6 combinations, got %d", len(result))
+	}
+
+	if result[0][0] != 1 || result[0][1] != 2 || result[5][0] != 3 || result[5][1] != 4 {
+		t.Errorf("Unexpected combinations: %v", result)
+	}
+
+	if len(combinations([]int{1, 2}, 3)) != 0 {
+		t.Error("Expected no combinations when k is larger than the number of items")
+	}
+}
+
+// Test the fish solver with an X-Wing.
+func TestFishStep(t *testing.T) {
+	solver := NewFishSolver(2)
+
+	// Restrict the value 1 to the columns 1 and 5 in the rows 1 and 5.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	for _, row := range []int{0, 4} {
+		for column := 0; column < 9; column++ {
+			if column != 0 && column != 4 {
+				grid.Eliminate(core.NewPosition(row, column), 1)
+			}
+		}
+	}
+
+	step := solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected an X-Wing step")
+	}
+
+	pattern, ok := step.Pattern.(FishPattern)
+	if !ok || pattern.Value != 1 ||
+		pattern.BaseSets[0] != core.NewRowHouse(0) || pattern.BaseSets[1] != core.NewRowHouse(4) ||
+		pattern.CoverSets[0] != core.NewColumnHouse(0) || pattern.CoverSets[1] != core.NewColumnHouse(4) {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	if len(step.Eliminations) != 14 {
+		t.Errorf("Expected 14 eliminations, got %d", len(step.Eliminations))
+	}
+}
+
+// Test the finned fish solver with a finned X-Wing.
+func TestFinnedFishStep(t *testing.T) {
+	solver := NewFinnedFishSolver(2)
+
+	// Restrict the value 1 to the columns 1 and 5 in the rows 1 and 5, with a fin at (1, 2).
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	for _, row := range []int{0, 4} {
+		for column := 0; column < 9; column++ {
+			if column != 0 && column != 4 && !(row == 0 && column == 1) {
+				grid.Eliminate(core.NewPosition(row, column), 1)
+			}
+		}
+	}
+
+	step := solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a finned X-Wing step")
+	}
+
+	pattern, ok := step.Pattern.(FishPattern)
+	if !ok || pattern.Variant != FinnedFish || len(pattern.Fins) != 1 || pattern.Fins[0] != core.NewPosition(0, 1) {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// Only the cells of the cover sets in the box of the fin are cleared.
+	if len(step.Eliminations) != 2 ||
+		step.Eliminations[0].Position != core.NewPosition(1, 0) || step.Eliminations[1].Position != core.NewPosition(2, 0) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	if NewSashimiFishSolver(2).FindStep(&grid) != nil {
+		t.Error("Expected no sashimi X-Wing")
+	}
+}
+
+// Function to restrict the candidates of a position in a grid for the tests.
+func restrictCandidates(grid *core.CandidateGrid, position core.Position, values ...int) {
+	keep := core.NewCandidateSet(values...)
+	for _, value := range grid.GetCandidates(position).Difference(keep).Digits() {
+		grid.Eliminate(position, value)
+	}
+}
+
+// Test the wings solver with an XY-Wing.
+func TestWingsStep(t *testing.T) {
+	solver := NewWingsSolver()
+
+	// The pivot (1, 1) holds {1, 2}, the pincers (1, 5) and (5, 1) hold {1, 3} and {2, 3}.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictCandidates(&grid, core.NewPosition(0, 0), 1, 2)
+	restrictCandidates(&grid, core.NewPosition(0, 4), 1, 3)
+	restrictCandidates(&grid, core.NewPosition(4, 0), 2, 3)
+
+	step := solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected an XY-Wing step")
+	}
+
+	pattern, ok := step.Pattern.(WingPattern)
+	if !ok || pattern.Name != "XY-Wing" || pattern.Value != 3 || *pattern.Pivot != core.NewPosition(0, 0) {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The only cell seeing both pincers is (5, 5).
+	if len(step.Eliminations) != 1 || step.Eliminations[0] != core.NewCell(core.NewPosition(4, 4), 3) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Test the single digit solvers with a Skyscraper.
+func TestSkyscraperStep(t *testing.T) {
+	// Restrict the value 1 to the columns 1 and 6 in the row 1, and to the columns 1 and 5 in the row 5.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	for column := 0; column < 9; column++ {
+		if column != 0 && column != 5 {
+			grid.Eliminate(core.NewPosition(0, column), 1)
+		}
+		if column != 0 && column != 4 {
+			grid.Eliminate(core.NewPosition(4, column), 1)
+		}
+	}
+
+	if NewTwoStringKiteSolver().FindStep(&grid) != nil || NewTurbotFishSolver().FindStep(&grid) != nil {
+		t.Error("Expected no 2-String Kite or Turbot Fish")
+	}
+
+	step := NewSkyscraperSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a Skyscraper step")
+	}
+
+	pattern, ok := step.Pattern.(SingleDigitPattern)
+	if !ok || pattern.Type != Skyscraper || pattern.Value != 1 || len(pattern.Links) != 2 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The cells seeing both (1, 6) and (5, 5) are cleared.
+	expected := []core.Position{core.NewPosition(1, 4), core.NewPosition(2, 4), core.NewPosition(3, 5), core.NewPosition(5, 5)}
+	if len(step.Eliminations) != len(expected) {
+		t.Fatalf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	for _, elimination := range step.Eliminations {
+		if !containsPosition(expected, elimination.Position) {
+			t.Errorf("Unexpected elimination: %s", elimination.ToString())
+		}
+	}
+}
+
+// Function to restrict the candidates of a value in a house to the positions for the tests.
+func restrictValueInHouse(grid *core.CandidateGrid, house core.House, value int, positions ...core.Position) {
+	for _, position := range house.GetPositions() {
+		if !containsPosition(positions, position) {
+			grid.Eliminate(position, value)
+		}
+	}
+}
+
+// Test the single digit solvers with a 2-String Kite.
+func TestTwoStringKiteStep(t *testing.T) {
+	// The row link (1, 2) = (1, 7) and the column link (3, 1) = (7, 1) are joined in the box 1.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewRowHouse(0), 1, core.NewPosition(0, 1), core.NewPosition(0, 6))
+	restrictValueInHouse(&grid, core.NewColumnHouse(0), 1, core.NewPosition(2, 0), core.NewPosition(6, 0))
+
+	if NewSkyscraperSolver().FindStep(&grid) != nil || NewTurbotFishSolver().FindStep(&grid) != nil {
+		t.Error("Expected no Skyscraper or Turbot Fish")
+	}
+
+	step := NewTwoStringKiteSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a 2-String Kite step")
+	}
+
+	pattern, ok := step.Pattern.(SingleDigitPattern)
+	if !ok || pattern.Type != TwoStringKite || pattern.Value != 1 || len(pattern.Links) != 2 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The only cell seeing both (1, 7) and (7, 1) is (7, 7).
+	if len(step.Eliminations) != 1 || step.Eliminations[0] != core.NewCell(core.NewPosition(6, 6), 1) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Test the single digit solvers with a Turbot Fish joining a column link and a box link.
+func TestTurbotFishStep(t *testing.T) {
+	// The column link (8, 1) = (2, 1) and the box link (2, 8) = (1, 9) are joined in the row 2.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewColumnHouse(0), 1, core.NewPosition(1, 0), core.NewPosition(7, 0))
+	restrictValueInHouse(&grid, core.NewBoxHouse(2), 1, core.NewPosition(1, 7), core.NewPosition(0, 8))
+
+	if NewSkyscraperSolver().FindStep(&grid) != nil || NewTwoStringKiteSolver().FindStep(&grid) != nil {
+		t.Error("Expected no Skyscraper or 2-String Kite")
+	}
+
+	step := NewTurbotFishSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a Turbot Fish step")
+	}
+
+	pattern, ok := step.Pattern.(SingleDigitPattern)
+	if !ok || pattern.Type != TurbotFish || pattern.Value != 1 || len(pattern.Links) != 2 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The only cell seeing both (8, 1) and (1, 9) is (8, 9).
+	if len(step.Eliminations) != 1 || step.Eliminations[0] != core.NewCell(core.NewPosition(7, 8), 1) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Test the single digit solvers with an Empty Rectangle, including a box with two crosses.
+func TestEmptyRectangleStep(t *testing.T) {
+	solver := NewEmptyRectangleSolver()
+
+	// The value 1 in the box 1 is confined to the cross of the row 2 and the column 2,
+	// and the column link (8, 6) = (2, 6) ends on the row of the cross.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewBoxHouse(0), 1,
+		core.NewPosition(0, 1), core.NewPosition(1, 0), core.NewPosition(1, 1), core.NewPosition(1, 2), core.NewPosition(2, 1))
+	restrictValueInHouse(&grid, core.NewColumnHouse(5), 1, core.NewPosition(1, 5), core.NewPosition(7, 5))
+
+	step := solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected an Empty Rectangle step")
+	}
+
+	pattern, ok := step.Pattern.(SingleDigitPattern)
+	if !ok || pattern.Type != EmptyRectangle || pattern.Value != 1 || *pattern.Box != core.NewBoxHouse(0) || len(pattern.Links) != 1 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The cell (8, 2) on the column of the cross seeing (8, 6) is cleared.
+	if len(step.Eliminations) != 1 || step.Eliminations[0] != core.NewCell(core.NewPosition(7, 1), 1) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	// With only (1, 2) and (2, 1) left in the box, both the row 1 with the column 1 and the row 2 with the column 2 are crosses.
+	// The column link only ends on the row of the second cross, which must still be found.
+	grid = core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewBoxHouse(0), 1, core.NewPosition(0, 1), core.NewPosition(1, 0))
+	restrictValueInHouse(&grid, core.NewColumnHouse(5), 1, core.NewPosition(1, 5), core.NewPosition(7, 5))
+
+	step = solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected an Empty Rectangle step on the second cross")
+	}
+
+	if len(step.Eliminations) != 1 || step.Eliminations[0] != core.NewCell(core.NewPosition(7, 1), 1) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Test the coloring solver with a color trap.
+func TestColoringStep(t *testing.T) {
+	// Build the chain (1, 1) = (5, 1) = (5, 6) = (2, 6) of strong links on the value 1.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	for i := 0; i < 9; i++ {
+		if i != 0 && i != 4 {
+			grid.Eliminate(core.NewPosition(i, 0), 1)
+		}
+		if i != 0 && i != 5 {
+			grid.Eliminate(core.NewPosition(4, i), 1)
+		}
+		if i != 1 && i != 4 {
+			grid.Eliminate(core.NewPosition(i, 5), 1)
+		}
+	}
+
+	step := NewColoringSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a coloring step")
+	}
+
+	pattern, ok := step.Pattern.(ColoringPattern)
+	if !ok || pattern.Rule != ColorTrap || len(pattern.Colors[0]) != 2 || len(pattern.Colors[1]) != 2 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The cells seeing both (1, 1) and (2, 6) are cleared.
+	expected := []core.Position{core.NewPosition(0, 3), core.NewPosition(0, 4), core.NewPosition(1, 1), core.NewPosition(1, 2)}
+	if len(step.Eliminations) != len(expected) {
+		t.Fatalf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	for i, elimination := range step.Eliminations {
+		if elimination.Position != expected[i] {
+			t.Errorf("Unexpected elimination: %s", elimination.ToString())
+		}
+	}
+}
+
+// Test the coloring solver with a color wrap.
+func TestColorWrapStep(t *testing.T) {
+	// Build the chain (1, 1) = (1, 5) = (5, 5) = (5, 2) = (2, 2) of strong links on the value 1,
+	// where (1, 1) and (2, 2) have the same color and see each other in the box 1.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewRowHouse(0), 1, core.NewPosition(0, 0), core.NewPosition(0, 4))
+	restrictValueInHouse(&grid, core.NewColumnHouse(4), 1, core.NewPosition(0, 4), core.NewPosition(4, 4))
+	restrictValueInHouse(&grid, core.NewRowHouse(4), 1, core.NewPosition(4, 4), core.NewPosition(4, 1))
+	restrictValueInHouse(&grid, core.NewColumnHouse(1), 1, core.NewPosition(4, 1), core.NewPosition(1, 1))
+
+	step := NewColoringSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a coloring step")
+	}
+
+	pattern, ok := step.Pattern.(ColoringPattern)
+	if !ok || pattern.Rule != ColorWrap || pattern.Value != 1 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The whole color of (1, 1) and (2, 2) is removed.
+	if len(step.Eliminations) != 3 ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(0, 0), 1)) ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(4, 4), 1)) ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(1, 1), 1)) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Test the coloring solver with a multi-color trap and a multi-color wrap on two clusters.
+func TestMultiColoringStep(t *testing.T) {
+	// The clusters (1, 1) = (1, 5) and (3, 2) = (7, 2) are linked by (1, 1) seeing (3, 2) in the box 1,
+	// so either (1, 5) or (7, 2) holds the value.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewRowHouse(0), 1, core.NewPosition(0, 0), core.NewPosition(0, 4))
+	restrictValueInHouse(&grid, core.NewColumnHouse(1), 1, core.NewPosition(2, 1), core.NewPosition(6, 1))
+
+	step := NewColoringSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a multi-coloring step")
+	}
+
+	pattern, ok := step.Pattern.(ColoringPattern)
+	if !ok || pattern.Rule != MultiColorTrap || pattern.OtherColors == nil {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The only cell seeing both (1, 5) and (7, 2) is (7, 5).
+	if len(step.Eliminations) != 1 || step.Eliminations[0] != core.NewCell(core.NewPosition(6, 4), 1) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	// The color of (1, 1) and (6, 5) sees both (2, 2) and (6, 2) of the cluster (2, 2) = (6, 2), so it is false.
+	grid = core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewRowHouse(0), 1, core.NewPosition(0, 0), core.NewPosition(0, 4))
+	restrictValueInHouse(&grid, core.NewColumnHouse(4), 1, core.NewPosition(0, 4), core.NewPosition(5, 4))
+	restrictValueInHouse(&grid, core.NewColumnHouse(1), 1, core.NewPosition(1, 1), core.NewPosition(5, 1))
+
+	step = NewColoringSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a multi-coloring step")
+	}
+
+	pattern, ok = step.Pattern.(ColoringPattern)
+	if !ok || pattern.Rule != MultiColorWrap || pattern.OtherColors == nil {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	if len(step.Eliminations) != 2 ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(0, 0), 1)) ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(5, 4), 1)) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Test the chain solvers with an XY-Chain through four bivalue cells.
+func TestChainStep(t *testing.T) {
+	// The chain (1, 1){1, 2} - (1, 5){2, 3} - (5, 5){3, 4} - (5, 9){4, 1} ends with 1 at (1, 1) and (5, 9).
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictCandidates(&grid, core.NewPosition(0, 0), 1, 2)
+	restrictCandidates(&grid, core.NewPosition(0, 4), 2, 3)
+	restrictCandidates(&grid, core.NewPosition(4, 4), 3, 4)
+	restrictCandidates(&grid, core.NewPosition(4, 8), 4, 1)
+
+	step := NewChainSolver(XYChain, DefaultMaximumChainLength).FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected an XY-Chain step")
+	}
+
+	pattern, ok := step.Pattern.(ChainPattern)
+	if !ok || pattern.Kind != XYChain || len(pattern.Nodes) != 8 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	for i, node := range pattern.Nodes {
+		if (i%2 == 0) != (node.Link == ChainLinkStrong) {
+			t.Errorf("Unexpected link after node %d: %s", i, step.ToString())
+		}
+	}
+
+	// The cells seeing both ends are (1, 9) and (5, 1).
+	if len(step.Eliminations) != 2 ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(0, 8), 1)) ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(4, 0), 1)) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	if NewChainSolver(XYChain, 6).FindStep(&grid) != nil {
+		t.Error("Expected no XY-Chain with up to 6 nodes")
+	}
+}
+
+// Test the chain solvers with an X-Chain of three strong links on a value.
+func TestXChainStep(t *testing.T) {
+	// The chain (1, 1) = (1, 5) - (5, 5) = (5, 8) - (8, 8) = (8, 3) on the value 1 ends at (1, 1) and (8, 3).
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewRowHouse(0), 1, core.NewPosition(0, 0), core.NewPosition(0, 4))
+	restrictValueInHouse(&grid, core.NewRowHouse(4), 1, core.NewPosition(4, 4), core.NewPosition(4, 7))
+	restrictValueInHouse(&grid, core.NewRowHouse(7), 1, core.NewPosition(7, 7), core.NewPosition(7, 2))
+
+	step := NewChainSolver(XChain, DefaultMaximumChainLength).FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected an X-Chain step")
+	}
+
+	pattern, ok := step.Pattern.(ChainPattern)
+	if !ok || pattern.Kind != XChain || len(pattern.Nodes) != 6 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The cells seeing both ends are (2, 3) and (3, 3) in the box 1, (7, 1) and (9, 1) in the box 7.
+	expected := []core.Position{core.NewPosition(1, 2), core.NewPosition(2, 2), core.NewPosition(6, 0), core.NewPosition(8, 0)}
+	if len(step.Eliminations) != len(expected) {
+		t.Fatalf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	for _, position := range expected {
+		if !containsCell(step.Eliminations, core.NewCell(position, 1)) {
+			t.Errorf("Expected the elimination of 1 at %s: %s", position.ToString(), step.ToString())
+		}
+	}
+
+	if NewChainSolver(XChain, 5).FindStep(&grid) != nil {
+		t.Error("Expected no X-Chain with up to 5 nodes")
+	}
+}
+
+// Test the chain solvers with an AIC through a grouped node.
+func TestGroupedChainStep(t *testing.T) {
+	// The value 1 of the row 1 is in (1, 1) or in the group (1, 4)(1, 5) of the box 2, which sees the column link (2, 6) = (8, 6).
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictValueInHouse(&grid, core.NewRowHouse(0), 1, core.NewPosition(0, 0), core.NewPosition(0, 3), core.NewPosition(0, 4))
+	restrictValueInHouse(&grid, core.NewColumnHouse(5), 1, core.NewPosition(1, 5), core.NewPosition(7, 5))
+
+	if NewChainSolver(XChain, DefaultMaximumChainLength).FindStep(&grid) != nil {
+		t.Error("Expected no X-Chain without grouped nodes")
+	}
+
+	step := NewChainSolver(AlternatingInference, DefaultMaximumChainLength).FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected an AIC step")
+	}
+
+	pattern, ok := step.Pattern.(ChainPattern)
+	if !ok || pattern.Kind != AlternatingInference || len(pattern.Nodes) != 4 || len(pattern.Nodes[1].Positions) != 2 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// The only cell seeing both (1, 1) and (8, 6) is (8, 1).
+	if len(step.Eliminations) != 1 || step.Eliminations[0] != core.NewCell(core.NewPosition(7, 0), 1) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Function to check if the cells contain the cell.
+func containsCell(cells []core.Cell, cell core.Cell) bool {
+	for _, other := range cells {
+		if other == cell {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Test the uniqueness solver with a Unique Rectangle type 1, and its uniqueness precondition.
+func TestUniquenessStep(t *testing.T) {
+	solver := NewUniquenessSolver()
+	if !solver.RequiresUniqueness() || NewSinglesSolver().RequiresUniqueness() {
+		t.Error("Expected only the uniqueness solver to require uniqueness")
+	}
+
+	// The corners (1, 1), (1, 4) and (2, 1) hold {1, 2}, the fourth corner (2, 4) holds {1, 2, 3}.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictCandidates(&grid, core.NewPosition(0, 0), 1, 2)
+	restrictCandidates(&grid, core.NewPosition(0, 3), 1, 2)
+	restrictCandidates(&grid, core.NewPosition(1, 0), 1, 2)
+	restrictCandidates(&grid, core.NewPosition(1, 3), 1, 2, 3)
+
+	step := solver.FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a Unique Rectangle step")
+	}
+
+	pattern, ok := step.Pattern.(UniquenessPattern)
+	if !ok || pattern.Type != UniqueRectangleType1 || pattern.Values != core.NewCandidateSet(1, 2) {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	if len(step.Eliminations) != 2 ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(1, 3), 1)) ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(1, 3), 2)) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+
+	// The empty board has many solutions, so the solver must not work on it.
+	board := core.NewEmptySudokuBoard()
+	if solver.Solve(&board) || solver.ExplainHint(&board) != nil {
+		t.Error("Expected the uniqueness solver to give up on a board with several solutions")
+	}
+
+	// The uniqueness check stops when the context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := solver.SolveContext(ctx, &board); err == nil {
+		t.Error("Expected an error when solving with a cancelled context")
+	}
+
+	if _, err := solver.ExplainHintContext(ctx, &board); err == nil {
+		t.Error("Expected an error when explaining a hint with a cancelled context")
+	}
+
+	// The context is also checked periodically while counting, to stop a long count.
+	timeoutCtx, timeoutCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer timeoutCancel()
+
+	if _, err := countGridSolutions(timeoutCtx, core.NewCandidateGrid(board), math.MaxInt); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the count to stop at the deadline, got %v", err)
+	}
+}
+
+// Test the uniqueness solver with the Unique Rectangle types 2 to 4 and the Hidden Unique Rectangle.
+// The rectangle is always on the corners (1, 1), (1, 4), (2, 1) and (2, 4) with the pair {1, 2}.
+func TestUniqueRectangleSteps(t *testing.T) {
+	corners := []core.Position{core.NewPosition(0, 0), core.NewPosition(0, 3), core.NewPosition(1, 0), core.NewPosition(1, 3)}
+
+	testCases := []struct {
+		name         string
+		restrict     func(grid *core.CandidateGrid)
+		patternType  UniquenessPatternType
+		eliminations int
+		elimination  core.Cell // One of the expected eliminations.
+	}{
+		{
+			// The roof holds {1, 2, 3}, the 3 is removed from the rest of the row 2.
+			name: "type 2",
+			restrict: func(grid *core.CandidateGrid) {
+				restrictCandidates(grid, corners[0], 1, 2)
+				restrictCandidates(grid, corners[1], 1, 2)
+				restrictCandidates(grid, corners[2], 1, 2, 3)
+				restrictCandidates(grid, corners[3], 1, 2, 3)
+			},
+			patternType:  UniqueRectangleType2,
+			eliminations: 7,
+			elimination:  core.NewCell(core.NewPosition(1, 8), 3),
+		},
+		{
+			// The extras {3, 4} of the roof form a naked pair with (2, 7), removed from the rest of the row 2.
+			name: "type 3",
+			restrict: func(grid *core.CandidateGrid) {
+				restrictCandidates(grid, corners[0], 1, 2)
+				restrictCandidates(grid, corners[1], 1, 2)
+				restrictCandidates(grid, corners[2], 1, 2, 3)
+				restrictCandidates(grid, corners[3], 1, 2, 4)
+				restrictCandidates(grid, core.NewPosition(1, 6), 3, 4)
+			},
+			patternType:  UniqueRectangleType3,
+			eliminations: 12,
+			elimination:  core.NewCell(core.NewPosition(1, 8), 4),
+		},
+		{
+			// The 1 of the row 2 is locked in the roof, so the 2 is removed from the roof.
+			name: "type 4",
+			restrict: func(grid *core.CandidateGrid) {
+				restrictCandidates(grid, corners[0], 1, 2)
+				restrictCandidates(grid, corners[1], 1, 2)
+				restrictValueInHouse(grid, core.NewRowHouse(1), 1, corners[2], corners[3])
+			},
+			patternType:  UniqueRectangleType4,
+			eliminations: 2,
+			elimination:  core.NewCell(corners[2], 2),
+		},
+		{
+			// Only (1, 1) is bivalue, and the 1 has strong links from the opposite corner (2, 4) in its row and column.
+			name: "hidden",
+			restrict: func(grid *core.CandidateGrid) {
+				restrictCandidates(grid, corners[0], 1, 2)
+				restrictValueInHouse(grid, core.NewRowHouse(1), 1, corners[2], corners[3])
+				restrictValueInHouse(grid, core.NewColumnHouse(3), 1, corners[1], corners[3])
+			},
+			patternType:  HiddenUniqueRectangle,
+			eliminations: 1,
+			elimination:  core.NewCell(corners[3], 2),
+		},
+	}
+
+	for _, testCase := range testCases {
+		grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+		testCase.restrict(&grid)
+
+		step := NewUniquenessSolver().FindStep(&grid)
+		if step == nil {
+			t.Errorf("Expected a Unique Rectangle %s step", testCase.name)
+			continue
+		}
+
+		pattern, ok := step.Pattern.(UniquenessPattern)
+		if !ok || pattern.Type != testCase.patternType || pattern.Values != core.NewCandidateSet(1, 2) {
+			t.Errorf("Unexpected pattern for the %s: %s", testCase.name, step.ToString())
+			continue
+		}
+
+		if len(step.Eliminations) != testCase.eliminations || !containsCell(step.Eliminations, testCase.elimination) {
+			t.Errorf("Unexpected eliminations for the %s: %s", testCase.name, step.ToString())
+		}
+	}
+}
+
+// Test the uniqueness solver with a BUG+1.
+func TestBivalueUniversalGraveStep(t *testing.T) {
+	// Every cell holds its value in a solution and the next value, so every value is twice in every house.
+	// The extra 9 of (1, 1) is the only candidate breaking the grave, so it must be placed.
+	solution := newBoardFromString("417369825632158947958724316825437169791586432346912758289643571573291684164875293")
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			value := solution.Get(position)
+			if row == 0 && column == 0 {
+				restrictCandidates(&grid, position, value, value%9+1, 9)
+			} else {
+				restrictCandidates(&grid, position, value, value%9+1)
+			}
+		}
+	}
+
+	step := NewUniquenessSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a BUG+1 step")
+	}
+
+	pattern, ok := step.Pattern.(UniquenessPattern)
+	if !ok || pattern.Type != BivalueUniversalGrave || pattern.Values != core.NewCandidateSet(9) {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	if len(step.Placements) != 1 || step.Placements[0] != core.NewCell(core.NewPosition(0, 0), 9) || len(step.Eliminations) != 0 {
+		t.Errorf("Unexpected step: %s", step.ToString())
+	}
+
+	// Without the extra candidate, the grave has no way out.
+	grid.Eliminate(core.NewPosition(0, 0), 9)
+	if NewUniquenessSolver().FindStep(&grid) != nil {
+		t.Error("Expected no uniqueness step on a grave without an extra candidate")
+	}
+}
+
+// Test the almost locked sets rules while solving a hard board: each rule applies and removes no value of the solution.
+func TestAlmostLockedSetsStep(t *testing.T) {
+	solver := NewAlmostLockedSetsSolver()
+	board := newBoardFromString("4.....8.5.3..........7......2.....6.....8.4......1.......6.3.7.5..2.....1.4......")
+	solution := newBoardFromString("417369825632158947958724316825437169791586432346912758289643571573291684164875293")
+
+	applied := map[AlmostLockedSetsRule]bool{}
+	grid := core.NewCandidateGrid(board)
+	for !grid.IsSolved() && len(applied) < len(almostLockedSetsRuleNames) {
+		sets := findAlmostLockedSets(&grid)
+		links := linkAlmostLockedSets(&grid, sets)
+
+		for _, step := range []*SolveStep{findAlsXZ(&grid, sets, links), findAlsXYWing(&grid, sets, links), findDeathBlossom(&grid, sets)} {
+			if step == nil {
+				continue
+			}
+
+			for _, elimination := range step.Eliminations {
+				if solution.Get(elimination.Position) == elimination.Value {
+					t.Fatalf("Wrong elimination %s: %s", elimination.ToString(), step.ToString())
+				}
+			}
+
+			applied[step.Pattern.(AlmostLockedSetsPattern).Rule] = true
+		}
+
+		step := solver.nextStep(&grid)
+		if step == nil {
+			t.Fatal("Expected the almost locked sets solver to progress")
+		}
+
+		step.Apply(&grid)
+	}
+
+	for rule, name := range almostLockedSetsRuleNames {
+		if !applied[rule] {
+			t.Errorf("Expected a %s step", name)
+		}
+	}
+}
+
+// Test the Sue de Coq solver on the intersection of the box 1 and the row 1.
+func TestSueDeCoqStep(t *testing.T) {
+	// The intersection cells (1, 1) and (1, 2) hold {1, 2, 3, 4}, with (1, 6) holding {1, 2} and (2, 1) holding {3, 4}.
+	grid := core.NewCandidateGrid(core.NewEmptySudokuBoard())
+	restrictCandidates(&grid, core.NewPosition(0, 0), 1, 2, 3, 4)
+	restrictCandidates(&grid, core.NewPosition(0, 1), 1, 2, 3, 4)
+	restrictCandidates(&grid, core.NewPosition(0, 5), 1, 2)
+	restrictCandidates(&grid, core.NewPosition(1, 0), 3, 4)
+
+	step := NewSueDeCoqSolver().FindStep(&grid)
+	if step == nil {
+		t.Fatal("Expected a Sue de Coq step")
+	}
+
+	pattern, ok := step.Pattern.(SueDeCoqPattern)
+	if !ok || pattern.Box != core.NewBoxHouse(0) || pattern.Line != core.NewRowHouse(0) ||
+		len(pattern.Intersection) != 2 || len(pattern.LineCells) != 1 || len(pattern.BoxCells) != 1 {
+		t.Fatalf("Unexpected pattern: %s", step.ToString())
+	}
+
+	// {1, 2} leave the rest of the row, {3, 4} the rest of the box, and (1, 3) in both loses all of them.
+	if len(step.Eliminations) != 24 ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(0, 8), 2)) ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(2, 2), 3)) ||
+		!containsCell(step.Eliminations, core.NewCell(core.NewPosition(0, 2), 4)) ||
+		containsCell(step.Eliminations, core.NewCell(core.NewPosition(0, 8), 3)) {
+		t.Errorf("Unexpected eliminations: %s", step.ToString())
+	}
+}
+
+// Test the forcing chain solver finds the shortest contradictions within its depth while solving a hard board.
+func TestForcingChainStep(t *testing.T) {
+	solver := NewForcingChainSolver(DefaultForcingChainDepth)
+	board := newBoardFromString("48.3............71.2.......7.5....6....2..8.............1.76...3.....4......5....")
+	solution := newBoardFromString("487312695593684271126597384735849162914265837268731549851476923379128456642953718")
+
+	grid := core.NewCandidateGrid(board)
+	for !grid.IsSolved() {
+		step := solver.nextStep(&grid)
+		if step == nil {
+			t.Fatal("Expected the forcing chain solver to progress")
+		}
+
+		if pattern, ok := step.Pattern.(ForcingChainPattern); ok {
+			if pattern.Contradiction == "" || len(pattern.Implications) > DefaultForcingChainDepth ||
+				len(step.Eliminations) != 1 || step.Eliminations[0] != pattern.Assumption ||
+				solution.Get(pattern.Assumption.Position) == pattern.Assumption.Value {
+				t.Fatalf("Unexpected step: %s", step.ToString())
+			}
+
+			// The chain is the shortest one: no chain is found with a smaller depth.
+			if len(pattern.Implications) > 1 {
+				if NewForcingChainSolver(len(pattern.Implications)-1).FindStep(&grid) != nil {
+					t.Errorf("Expected no forcing chain shorter than: %s", step.ToString())
+				}
+
+				return
+			}
+		}
+
+		step.Apply(&grid)
+	}
+
+	t.Error("Expected a forcing chain with more than one implication")
+}
+
+// Test the solve trace with a chain of strategy solvers from the store.
+func TestTraceSolve(t *testing.T) {
+	store := NewSudokuSolverStore()
+	if _, err := store.GetStrategySolversByKeys([]string{"singles", "default"}); err == nil {
+		t.Error("Expected an error for a solver key that is not a strategy solver")
+	}
+
+	solvers, err := store.GetStrategySolversByKeys([]string{"singles", "locked-candidates"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	board := newBoardFromString("583.67..46723.48...4.8253.6934..852.2.74519.3851.3.4673..589742.952461.84.87..659")
+	trace := TraceSolve(&board, solvers)
+	if !trace.Solved || trace.Board.ToString() != "583167294672394815149825376934678521267451983851932467316589742795246138428713659" {
+		t.Fatalf("Unexpected trace board: %s", trace.Board.ToString())
+	}
+
+	if board.GetFilledCellsCount() != 81-len(trace.Steps) {
+		t.Errorf("Expected one step per empty cell and the board untouched, got %d steps", len(trace.Steps))
+	}
+
+	// Every step records the grid before it, with the placed value still a candidate.
+	firstGrid := core.NewCandidateGrid(board)
+	if trace.Steps[0].Grid.ToString() != firstGrid.ToString() {
+		t.Errorf("Unexpected grid before the first step:\n%s", trace.Steps[0].Grid.ToString())
+	}
+
+	for _, step := range trace.Steps {
+		placement := step.Placements[0]
+		if step.Technique != "singles" || !step.Grid.HasCandidate(placement.Position, placement.Value) {
+			t.Errorf("Unexpected step: %s", step.SolveStep.ToString())
+		}
+	}
+
+	if !strings.HasPrefix(trace.ToString(), "1. singles: ") {
+		t.Errorf("Unexpected walkthrough: %s", trace.ToString())
+	}
+
+	// The uniqueness solver is skipped once the singles are stuck on a board with several solutions.
+	solvers, err = store.GetStrategySolversByKeys([]string{"singles", "uniqueness"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	board = core.NewEmptySudokuBoard()
+	trace = TraceSolve(&board, solvers)
+	if trace.Solved || len(trace.Steps) != 0 {
+		t.Errorf("Expected no step on a board with several solutions, got %d steps", len(trace.Steps))
+	}
+}
+
+// Test the rater with the default ladder and a limited one.
+func TestSudokuRater(t *testing.T) {
+	store := NewSudokuSolverStore()
+	if _, err := NewSudokuRaterWithRatings(store, []TechniqueRating{{Key: "unknown", Score: 1}}); err == nil {
+		t.Error("Expected an error for an unknown technique")
+	}
+
+	rater := NewSudokuRater(store)
+	board := newBoardFromString("583.67..46723.48...4.8253.6934..852.2.74519.3851.3.4673..589742.952461.84.87..659")
+	rating := rater.Rate(&board)
+	if !rating.Solved || rating.Score != 1.5 || rating.HardestTechnique != "singles" || rating.TechniqueCounts["singles"] != 81-board.GetFilledCellsCount() {
+		t.Errorf("Unexpected rating: %+v", rating)
+	}
+
+	// A hard board cannot be solved by singles alone.
+	board = newBoardFromString("4.....8.5.3..........7......2.....6.....8.4......1.......6.3.7.5..2.....1.4......")
+	rating = rater.WithMaximumScore(1.5).Rate(&board)
+	if rating.Solved || rating.Score != BacktrackingScore || rating.HardestTechnique != "default" {
+		t.Errorf("Unexpected rating: %+v", rating)
+	}
+
+	if rater.GetScore("forcing-chains") <= rater.GetScore("aic") || rater.GetScore("unknown") != 0 {
+		t.Error("Unexpected technique scores")
+	}
+}
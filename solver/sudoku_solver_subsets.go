@@ -0,0 +1,143 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the subsets solver object.
+type SubsetsSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default SubsetsSolver object.
+func NewSubsetsSolver() SubsetsSolver {
+	return SubsetsSolver{
+		newStrategySolver(BaseSolver{
+			Key:         "subsets",
+			DisplayName: "Subsets Solver",
+			Description: `Strategy solver using singles and naked or hidden pairs, triples and quads in rows, columns and boxes.`,
+			Reliable:    false,
+		}, findSubsetsStep),
+	}
+}
+
+// Define the pattern of a naked or hidden subset.
+type SubsetPattern struct {
+	Hidden    bool              // A hidden subset if true, otherwise a naked subset.
+	House     core.House        // The house containing the subset.
+	Positions []core.Position   // The positions of the subset.
+	Values    core.CandidateSet // The values of the subset.
+}
+
+// Function to print the subset pattern.
+func (pattern SubsetPattern) ToString() string {
+	kind := "naked"
+	if pattern.Hidden {
+		kind = "hidden"
+	}
+
+	names := map[int]string{2: "pair", 3: "triple", 4: "quad"}
+
+	return fmt.Sprintf("%s %s %s in %s at %s",
+		kind, names[len(pattern.Positions)], pattern.Values.ToString(), pattern.House.ToString(), formatPositions(pattern.Positions))
+}
+
+// Function to find a naked subset of the given size in a house:
+// k cells with only k candidates in total, which can be removed from the other cells of the house.
+func findNakedSubsetIn(grid *core.CandidateGrid, house core.House, size int) *SolveStep {
+	emptyPositions := grid.GetEmptyPositions(house)
+
+	for _, positions := range combinations(emptyPositions, size) {
+		values := core.NewCandidateSet()
+		for _, position := range positions {
+			values = values.Union(grid.GetCandidates(position))
+		}
+
+		if values.Count() != size {
+			continue
+		}
+
+		eliminations := []core.Cell{}
+		for _, position := range emptyPositions {
+			if containsPosition(positions, position) {
+				continue
+			}
+
+			for _, value := range grid.GetCandidates(position).Intersect(values).Digits() {
+				eliminations = append(eliminations, core.NewCell(position, value))
+			}
+		}
+
+		if len(eliminations) > 0 {
+			return &SolveStep{
+				Eliminations: eliminations,
+				Pattern:      SubsetPattern{Hidden: false, House: house, Positions: positions, Values: values},
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find a hidden subset of the given size in a house:
+// k values restricted to k cells, so the other candidates can be removed from these cells.
+func findHiddenSubsetIn(grid *core.CandidateGrid, house core.House, size int) *SolveStep {
+	unplacedValues := []int{}
+	for value := 1; value <= 9; value++ {
+		if !grid.IsPlacedIn(house, value) {
+			unplacedValues = append(unplacedValues, value)
+		}
+	}
+
+	for _, valueCombination := range combinations(unplacedValues, size) {
+		values := core.NewCandidateSet(valueCombination...)
+
+		positions := []core.Position{}
+		for _, position := range grid.GetEmptyPositions(house) {
+			if !grid.GetCandidates(position).Intersect(values).IsEmpty() {
+				positions = append(positions, position)
+			}
+		}
+
+		if len(positions) != size {
+			continue
+		}
+
+		eliminations := []core.Cell{}
+		for _, position := range positions {
+			for _, value := range grid.GetCandidates(position).Difference(values).Digits() {
+				eliminations = append(eliminations, core.NewCell(position, value))
+			}
+		}
+
+		if len(eliminations) > 0 {
+			return &SolveStep{
+				Eliminations: eliminations,
+				Pattern:      SubsetPattern{Hidden: true, House: house, Positions: positions, Values: values},
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find the next subset step, smaller subsets first and naked before hidden.
+func findSubsetsStep(grid *core.CandidateGrid) *SolveStep {
+	for size := 2; size <= 4; size++ {
+		for _, house := range core.AllHouses() {
+			if step := findNakedSubsetIn(grid, house, size); step != nil {
+				return step
+			}
+		}
+
+		for _, house := range core.AllHouses() {
+			if step := findHiddenSubsetIn(grid, house, size); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
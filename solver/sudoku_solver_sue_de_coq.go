@@ -0,0 +1,162 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the Sue de Coq solver object.
+type SueDeCoqSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default SueDeCoqSolver object.
+func NewSueDeCoqSolver() SueDeCoqSolver {
+	return SueDeCoqSolver{
+		newStrategySolver(BaseSolver{
+			Key:         "sue-de-coq",
+			DisplayName: "Sue de Coq Solver",
+			Description: `Strategy solver using singles and Sue de Coq: cells of a box-line intersection locked together with cells of the line and cells of the box.`,
+			Reliable:    false,
+		}, findSueDeCoqStep),
+	}
+}
+
+// Define the pattern of a Sue de Coq.
+type SueDeCoqPattern struct {
+	Box          core.House        // The box of the intersection.
+	Line         core.House        // The row or column of the intersection.
+	Intersection []core.Position   // The cells of the intersection.
+	LineCells    []core.Position   // The cells of the line outside of the box.
+	BoxCells     []core.Position   // The cells of the box outside of the line.
+	Values       core.CandidateSet // The candidates of the intersection cells.
+}
+
+// Function to print the Sue de Coq pattern.
+func (pattern SueDeCoqPattern) ToString() string {
+	return fmt.Sprintf("Sue de Coq in %s and %s with %s %s, %s cells %s and %s cells %s",
+		pattern.Box.ToString(), pattern.Line.ToString(), formatPositions(pattern.Intersection), pattern.Values.ToString(),
+		pattern.Line.ToString(), formatPositions(pattern.LineCells), pattern.Box.ToString(), formatPositions(pattern.BoxCells))
+}
+
+// Function to get the union of the candidates of the positions.
+func getCandidatesUnion(grid *core.CandidateGrid, positions []core.Position) core.CandidateSet {
+	values := core.CandidateSet(0)
+	for _, position := range positions {
+		values = values.Union(grid.GetCandidates(position))
+	}
+
+	return values
+}
+
+// Function to get the empty positions of the house outside of the excluded house, sharing a candidate with the values.
+func getSueDeCoqCandidates(grid *core.CandidateGrid, house core.House, excluded core.House, values core.CandidateSet) []core.Position {
+	positions := []core.Position{}
+	for _, position := range grid.GetEmptyPositions(house) {
+		if !excluded.Contains(position) && !grid.GetCandidates(position).Intersect(values).IsEmpty() {
+			positions = append(positions, position)
+		}
+	}
+
+	return positions
+}
+
+// Function to collect the eliminations of the values from the empty cells of the house, except the excluded positions.
+func collectHouseEliminations(grid *core.CandidateGrid, house core.House, values core.CandidateSet, excluded []core.Position) []core.Cell {
+	eliminations := []core.Cell{}
+	for _, position := range grid.GetEmptyPositions(house) {
+		if containsPosition(excluded, position) {
+			continue
+		}
+
+		for _, value := range grid.GetCandidates(position).Intersect(values).Digits() {
+			eliminations = append(eliminations, core.NewCell(position, value))
+		}
+	}
+
+	return eliminations
+}
+
+// Function to find a Sue de Coq on the intersection of a box and a line.
+// N intersection cells hold at least N+2 candidates. Together with line cells and box cells with disjoint candidates,
+// they form a set of cells holding as many candidates as cells, so every candidate is placed exactly once:
+// the line values and the intersection values not in the box cells are removed from the rest of the line,
+// and the box values and the intersection values not in the line cells are removed from the rest of the box.
+func findSueDeCoqOn(grid *core.CandidateGrid, box core.House, line core.House) *SolveStep {
+	intersection := []core.Position{}
+	for _, position := range grid.GetEmptyPositions(box) {
+		if line.Contains(position) {
+			intersection = append(intersection, position)
+		}
+	}
+
+	for size := 2; size <= len(intersection); size++ {
+		for _, cells := range combinations(intersection, size) {
+			values := getCandidatesUnion(grid, cells)
+			if values.Count() < size+2 {
+				continue
+			}
+
+			lineCandidates := getSueDeCoqCandidates(grid, line, box, values)
+			boxCandidates := getSueDeCoqCandidates(grid, box, line, values)
+			for lineSize := 1; lineSize <= 3; lineSize++ {
+				for _, lineCells := range combinations(lineCandidates, lineSize) {
+					lineValues := getCandidatesUnion(grid, lineCells)
+
+					for boxSize := 1; boxSize <= 3; boxSize++ {
+						for _, boxCells := range combinations(boxCandidates, boxSize) {
+							boxValues := getCandidatesUnion(grid, boxCells)
+							if !lineValues.Intersect(boxValues).IsEmpty() ||
+								values.Union(lineValues).Union(boxValues).Count() != size+lineSize+boxSize {
+								continue
+							}
+
+							lineExcluded := append(append([]core.Position{}, intersection...), lineCells...)
+							boxExcluded := append(append([]core.Position{}, intersection...), boxCells...)
+							eliminations := collectHouseEliminations(grid, line, lineValues.Union(values.Difference(boxValues)), lineExcluded)
+							eliminations = append(eliminations, collectHouseEliminations(grid, box, boxValues.Union(values.Difference(lineValues)), boxExcluded)...)
+
+							// The other intersection cells are in both houses, so they lose all the values.
+							for _, position := range intersection {
+								if containsPosition(cells, position) {
+									continue
+								}
+
+								for _, value := range grid.GetCandidates(position).Intersect(values.Union(lineValues).Union(boxValues)).Digits() {
+									eliminations = append(eliminations, core.NewCell(position, value))
+								}
+							}
+
+							if len(eliminations) > 0 {
+								return &SolveStep{
+									Eliminations: eliminations,
+									Pattern: SueDeCoqPattern{
+										Box: box, Line: line, Intersection: cells, LineCells: lineCells, BoxCells: boxCells, Values: values,
+									},
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find the next Sue de Coq step.
+func findSueDeCoqStep(grid *core.CandidateGrid) *SolveStep {
+	for box := 0; box < 9; box++ {
+		for i := 0; i < 3; i++ {
+			for _, line := range []core.House{core.NewRowHouse(box/3*3 + i), core.NewColumnHouse(box%3*3 + i)} {
+				if step := findSueDeCoqOn(grid, core.NewBoxHouse(box), line); step != nil {
+					return step
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gnailuy/sudoku/core"
+	"github.com/gnailuy/sudoku/util"
+)
+
+// Test the bounded solution counting of all the reliable solvers.
+func TestCountSolutionsUpTo(t *testing.T) {
+	// Removing all the 1s, 2s and 3s of a solved board gives several solutions, as they can be permuted.
+	board := newBoardFromString("583167294672394815149825376934678521267451983851932467316589742795246138428713659")
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			if position := core.NewPosition(row, column); board.Get(position) <= 3 {
+				board.Unset(position)
+			}
+		}
+	}
+
+	unique := newBoardFromString(benchmarkBoards[1])
+	store := NewSudokuSolverStore()
+	for _, key := range []string{"default", "dlx", "bitmask"} {
+		solver := store.GetSolverByKey(key)
+		total := solver.CountSolutions(&board)
+		if total < 3 {
+			t.Errorf("%s: expected at least 3 solutions, got %d", key, total)
+		}
+
+		for _, limit := range []int{1, 2, 3} {
+			if count := solver.CountSolutionsUpTo(&board, limit); count != limit {
+				t.Errorf("%s: expected %d solutions up to %d, got %d", key, limit, limit, count)
+			}
+		}
+
+		if count := solver.CountSolutionsUpTo(&board, 0); count != total {
+			t.Errorf("%s: expected %d solutions without limit, got %d", key, total, count)
+		}
+
+		if count := solver.CountSolutionsUpTo(&unique, 2); count != 1 {
+			t.Errorf("%s: expected 1 solution for a unique board, got %d", key, count)
+		}
+	}
+}
+
+// Test the reliable solvers stop with the error of the context when it is done.
+func TestSolveContext(t *testing.T) {
+	store := NewSudokuSolverStore()
+	for _, key := range []string{"default", "dlx", "bitmask"} {
+		solver := store.GetSolverByKey(key)
+
+		// A cancelled context stops before solving and leaves the board untouched.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		board := newBoardFromString(benchmarkBoards[1])
+		original := board.Copy()
+		if solved, err := solver.SolveContext(ctx, &board); solved || !errors.Is(err, context.Canceled) {
+			t.Errorf("%s: expected the solve to be cancelled, got %v and %v", key, solved, err)
+		}
+
+		if board.ToString() != original.ToString() {
+			t.Errorf("%s: expected the board to be left untouched", key)
+		}
+
+		// Counting all the solutions of an empty board never ends before the deadline.
+		ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+		empty := core.NewEmptySudokuBoard()
+		start := time.Now()
+		if _, err := solver.CountSolutionsUpToContext(ctx, &empty, 0); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s: expected the count to exceed the deadline, got %v", key, err)
+		}
+		cancel()
+
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("%s: expected the count to stop soon after the deadline, took %s", key, elapsed)
+		}
+
+		// Without deadline, the solve is not affected.
+		if solved, err := solver.SolveContext(context.Background(), &board); !solved || err != nil {
+			t.Errorf("%s: expected the board to be solved, got %v and %v", key, solved, err)
+		}
+	}
+}
+
+// Test the reliable solvers report the statistics of their search.
+func TestSolveStats(t *testing.T) {
+	store := NewSudokuSolverStore()
+	for _, key := range []string{"default", "dlx", "bitmask"} {
+		solver, ok := store.GetSolverByKey(key).(IInstrumentedSolver)
+		if !ok {
+			t.Fatalf("%s: expected an instrumented solver", key)
+		}
+
+		stats := SolveStats{}
+		board := newBoardFromString(benchmarkBoards[2])
+		solvedBoard := board.Copy()
+		if solved, err := solver.SolveWithStats(context.Background(), &solvedBoard, &stats); !solved || err != nil {
+			t.Fatalf("%s: expected the board to be solved, got %v and %v", key, solved, err)
+		}
+
+		if stats.NodeCount == 0 || stats.MaxDepth == 0 || stats.WallTime == 0 {
+			t.Errorf("%s: unexpected statistics: %s", key, stats.ToString())
+		}
+
+		// Only the default solver guesses every value.
+		if (key == "default") != (stats.Propagations == 0) {
+			t.Errorf("%s: unexpected propagations: %s", key, stats.ToString())
+		}
+
+		// The statistics of several searches are added up.
+		solveStats := stats
+		if _, err := solver.CountSolutionsWithStats(context.Background(), &board, 0, &stats); err != nil || stats.NodeCount <= solveStats.NodeCount {
+			t.Errorf("%s: expected the statistics to be added up, got %s", key, stats.ToString())
+		}
+
+		// The sink is optional.
+		if count, err := solver.CountSolutionsWithStats(context.Background(), &board, 0, nil); count != 1 || err != nil {
+			t.Errorf("%s: expected 1 solution without a sink, got %d and %v", key, count, err)
+		}
+	}
+
+	// Backtracking without propagation needs more guesses than the bitmask solver.
+	board := newBoardFromString(benchmarkBoards[2])
+	defaultStats, bitmaskStats := SolveStats{}, SolveStats{}
+	NewDefaultSolver().CountSolutionsWithStats(context.Background(), &board, 0, &defaultStats)
+	NewBitmaskSolver().CountSolutionsWithStats(context.Background(), &board, 0, &bitmaskStats)
+	if defaultStats.Backtracks <= bitmaskStats.Backtracks {
+		t.Errorf("Expected more backtracks for the default solver: %s and %s", defaultStats.ToString(), bitmaskStats.ToString())
+	}
+}
+
+// Test the randomized solvers make the same choices with the same seed.
+func TestWithRandomSource(t *testing.T) {
+	store := NewSudokuSolverStore()
+	for _, key := range []string{"default", "dlx", "bitmask", "bitmask-parallel"} {
+		randomizedSolver, ok := store.GetSolverByKey(key).(IRandomizedSolver)
+		if !ok {
+			t.Fatalf("%s: expected a randomized solver", key)
+		}
+
+		results := []string{}
+		for i := 0; i < 2; i++ {
+			solver := randomizedSolver.WithRandomSource(util.NewSeededRandomSource(42))
+			board := core.NewEmptySudokuBoard()
+			if !solver.Solve(&board) {
+				t.Fatalf("%s: expected the empty board to be solved", key)
+			}
+
+			problem := newBoardFromString(benchmarkBoards[1])
+			hint := solver.Hint(&problem)
+			results = append(results, board.ToString()+" "+hint.ToString())
+		}
+
+		if results[0] != results[1] {
+			t.Errorf("%s: expected the same results with the same seed, got %s and %s", key, results[0], results[1])
+		}
+	}
+}
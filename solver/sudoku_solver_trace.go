@@ -0,0 +1,98 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define a step of a solve trace.
+type TraceStep struct {
+	SolveStep                    // The step found, with the key of its technique, its placements and eliminations.
+	Grid      core.CandidateGrid // The candidate grid before the step.
+}
+
+// Define the trace of a step by step solve.
+type SolveTrace struct {
+	Steps  []TraceStep      // The steps in the order they were applied.
+	Board  core.SudokuBoard // The board after the last step.
+	Solved bool             // If the steps fully solved the board.
+}
+
+// Function to print the trace as a user facing walkthrough, one numbered step per line.
+func (trace *SolveTrace) ToString() string {
+	lines := make([]string, len(trace.Steps))
+	for i, step := range trace.Steps {
+		lines[i] = fmt.Sprintf("%d. %s", i+1, step.SolveStep.ToString())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Function to solve the board step by step with a chain of strategy solvers and record every step.
+// At each step the solvers are tried in order and the first step found is applied, so simpler solvers should come first.
+// Solvers requiring uniqueness are skipped if the board does not have a unique solution.
+// The solutions are only counted the first time such a solver is about to be tried.
+// The board is left untouched.
+func TraceSolve(board *core.SudokuBoard, solvers []IStrategySolver) SolveTrace {
+	trace, _ := TraceSolveContext(context.Background(), board, solvers)
+	return trace
+}
+
+// Function to solve the board step by step like TraceSolve, checking the context before every step.
+// On error, the trace holds the steps applied before the context was done.
+func TraceSolveContext(ctx context.Context, board *core.SudokuBoard, solvers []IStrategySolver) (SolveTrace, error) {
+	trace := SolveTrace{Steps: []TraceStep{}, Board: board.Copy()}
+	if !board.IsValid() {
+		return trace, ctx.Err()
+	}
+
+	grid := core.NewCandidateGrid(*board)
+	var isUnique *bool
+
+	for !grid.IsSolved() {
+		if err := ctx.Err(); err != nil {
+			trace.Board = grid.GetBoard()
+			return trace, err
+		}
+
+		var step *SolveStep
+		for _, solver := range solvers {
+			if solver.RequiresUniqueness() {
+				if isUnique == nil {
+					// The steps applied so far keep the solutions of the board, so the current grid can be counted.
+					count, err := countGridSolutions(ctx, grid.Copy(), 2)
+					if err != nil {
+						trace.Board = grid.GetBoard()
+						return trace, err
+					}
+
+					unique := count == 1
+					isUnique = &unique
+				}
+
+				if !*isUnique {
+					continue
+				}
+			}
+
+			if step = solver.FindStep(&grid); step != nil {
+				break
+			}
+		}
+
+		if step == nil {
+			break
+		}
+
+		trace.Steps = append(trace.Steps, TraceStep{SolveStep: *step, Grid: grid.Copy()})
+		step.Apply(&grid)
+	}
+
+	trace.Board = grid.GetBoard()
+	trace.Solved = grid.IsSolved()
+
+	return trace, nil
+}
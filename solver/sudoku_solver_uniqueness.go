@@ -0,0 +1,369 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the uniqueness solver object.
+type UniquenessSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default UniquenessSolver object.
+func NewUniquenessSolver() UniquenessSolver {
+	strategySolver := newStrategySolver(BaseSolver{
+		Key:         "uniqueness",
+		DisplayName: "Uniqueness Solver",
+		Description: `Strategy solver using singles, Unique Rectangles (types 1 to 4 and hidden) and BUG+1. ` +
+			`Requires a unique solution: these techniques avoid deadly patterns that only exist if the puzzle has several solutions, ` +
+			`so the solver gives up on boards that do not have exactly one solution.`,
+		Reliable: false,
+	}, findUniquenessStep)
+	strategySolver.requiresUniqueness = true
+
+	return UniquenessSolver{strategySolver}
+}
+
+// Define the types of the uniqueness patterns.
+type UniquenessPatternType int
+
+const (
+	UniqueRectangleType1  UniquenessPatternType = iota // Three corners hold only the pair, the pair is removed from the fourth.
+	UniqueRectangleType2                               // Two corners hold the pair and one same extra value, the extra is removed from the cells seeing both.
+	UniqueRectangleType3                               // The extras of two corners form a naked subset with other cells of a house.
+	UniqueRectangleType4                               // One value of the pair is locked in two corners, the other value is removed from them.
+	HiddenUniqueRectangle                              // Strong links on a value from the opposite corner, the other value is removed from it.
+	BivalueUniversalGrave                              // All cells but one are bivalue, the value appearing three times is placed.
+)
+
+// Names of the uniqueness pattern types.
+var uniquenessPatternNames = map[UniquenessPatternType]string{
+	UniqueRectangleType1:  "Unique Rectangle type 1",
+	UniqueRectangleType2:  "Unique Rectangle type 2",
+	UniqueRectangleType3:  "Unique Rectangle type 3",
+	UniqueRectangleType4:  "Unique Rectangle type 4",
+	HiddenUniqueRectangle: "Hidden Unique Rectangle",
+	BivalueUniversalGrave: "BUG+1",
+}
+
+// Define the pattern of a uniqueness step.
+type UniquenessPattern struct {
+	Type      UniquenessPatternType // The type of the pattern.
+	Values    core.CandidateSet     // The pair of values of the rectangle, or the extra value of BUG+1.
+	Positions []core.Position       // The corners of the rectangle, or the cell with three candidates of BUG+1.
+	Subset    []core.Position       // The other cells of the naked subset of a type 3 rectangle, nil otherwise.
+}
+
+// Function to print the uniqueness pattern.
+func (pattern UniquenessPattern) ToString() string {
+	result := fmt.Sprintf("%s on %s at %s", uniquenessPatternNames[pattern.Type], pattern.Values.ToString(), formatPositions(pattern.Positions))
+	if len(pattern.Subset) > 0 {
+		result += fmt.Sprintf(" with %s", formatPositions(pattern.Subset))
+	}
+
+	return result
+}
+
+// Function to count the solutions of the grid up to the limit, stopping with the error of the context when it is done.
+// Unlike the reliable solvers counting the solutions of a board, it respects the eliminations of the candidates.
+func countGridSolutions(ctx context.Context, grid core.CandidateGrid, limit int) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	check := newContextCheck(ctx)
+	count := countGridSolutionsFrom(&check, grid, limit)
+
+	return count, check.err
+}
+
+// Function to count the solutions of the grid up to the limit, by backtracking on the cell with the fewest candidates.
+func countGridSolutionsFrom(check *contextCheck, grid core.CandidateGrid, limit int) int {
+	if check.isDone() {
+		return 0
+	}
+
+	var best core.Position
+	bestCount := 10
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			if grid.Get(position) != 0 {
+				continue
+			}
+
+			count := grid.GetCandidates(position).Count()
+			if count < bestCount {
+				best, bestCount = position, count
+			}
+		}
+	}
+
+	// No empty cell left, the grid is a solution.
+	if bestCount == 10 {
+		return 1
+	}
+
+	numberOfSolutions := 0
+	for _, value := range grid.GetCandidates(best).Digits() {
+		next := grid.Copy()
+		next.Set(best, value)
+
+		numberOfSolutions += countGridSolutionsFrom(check, next, limit-numberOfSolutions)
+		if numberOfSolutions >= limit || check.err != nil {
+			break
+		}
+	}
+
+	return numberOfSolutions
+}
+
+// Function to find the rectangles of empty cells sharing the pair of values and spanning exactly two boxes.
+// The corners are ordered (r1, c1), (r1, c2), (r2, c1), (r2, c2).
+func findUniqueRectangles(grid *core.CandidateGrid, pair core.CandidateSet) [][4]core.Position {
+	rectangles := [][4]core.Position{}
+	for r1 := 0; r1 < 9; r1++ {
+		for r2 := r1 + 1; r2 < 9; r2++ {
+			for c1 := 0; c1 < 9; c1++ {
+				for c2 := c1 + 1; c2 < 9; c2++ {
+					if (r1/3 == r2/3) == (c1/3 == c2/3) {
+						continue
+					}
+
+					corners := [4]core.Position{
+						core.NewPosition(r1, c1), core.NewPosition(r1, c2),
+						core.NewPosition(r2, c1), core.NewPosition(r2, c2),
+					}
+
+					valid := true
+					for _, corner := range corners {
+						if grid.GetCandidates(corner).Intersect(pair) != pair {
+							valid = false
+							break
+						}
+					}
+
+					if valid {
+						rectangles = append(rectangles, corners)
+					}
+				}
+			}
+		}
+	}
+
+	return rectangles
+}
+
+// Function to find a step of the unique rectangle types 1 to 4.
+func findUniqueRectangleStep(grid *core.CandidateGrid, pair core.CandidateSet, corners [4]core.Position) *SolveStep {
+	floor, roof := []core.Position{}, []core.Position{}
+	for _, corner := range corners {
+		if grid.GetCandidates(corner) == pair {
+			floor = append(floor, corner)
+		} else {
+			roof = append(roof, corner)
+		}
+	}
+
+	newStep := func(patternType UniquenessPatternType, eliminations []core.Cell, subset []core.Position) *SolveStep {
+		if len(eliminations) == 0 {
+			return nil
+		}
+
+		return &SolveStep{
+			Eliminations: eliminations,
+			Pattern:      UniquenessPattern{Type: patternType, Values: pair, Positions: corners[:], Subset: subset},
+		}
+	}
+
+	// Type 1: the fourth corner cannot hold the pair.
+	if len(roof) == 1 {
+		eliminations := []core.Cell{}
+		for _, value := range pair.Digits() {
+			eliminations = append(eliminations, core.NewCell(roof[0], value))
+		}
+
+		return newStep(UniqueRectangleType1, eliminations, nil)
+	}
+
+	// The other types need the two roof corners to share a line.
+	if len(roof) != 2 || (roof[0].Row != roof[1].Row && roof[0].Column != roof[1].Column) {
+		return nil
+	}
+
+	extras := grid.GetCandidates(roof[0]).Union(grid.GetCandidates(roof[1])).Difference(pair)
+
+	// Type 2: one of the roof corners holds the only extra value.
+	if extras.Count() == 1 && grid.GetCandidates(roof[0]) == grid.GetCandidates(roof[1]) {
+		if step := newStep(UniqueRectangleType2, collectEliminations(grid, getCommonPeers(grid, roof[0], roof[1]), extras.First()), nil); step != nil {
+			return step
+		}
+	}
+
+	houses := []core.House{}
+	for _, house := range roof[0].GetHouses() {
+		if house.Contains(roof[1]) {
+			houses = append(houses, house)
+		}
+	}
+
+	// Type 3: the extras act as one cell forming a naked subset with other cells of a house of the roof.
+	for _, house := range houses {
+		others := []core.Position{}
+		for _, position := range grid.GetEmptyPositions(house) {
+			if position != roof[0] && position != roof[1] {
+				others = append(others, position)
+			}
+		}
+
+		for size := 1; size <= 3; size++ {
+			for _, subset := range combinations(others, size) {
+				values := extras
+				for _, position := range subset {
+					values = values.Union(grid.GetCandidates(position))
+				}
+
+				if values.Count() != size+1 {
+					continue
+				}
+
+				eliminations := []core.Cell{}
+				for _, position := range others {
+					if containsPosition(subset, position) {
+						continue
+					}
+
+					for _, value := range grid.GetCandidates(position).Intersect(values).Digits() {
+						eliminations = append(eliminations, core.NewCell(position, value))
+					}
+				}
+
+				if step := newStep(UniqueRectangleType3, eliminations, subset); step != nil {
+					return step
+				}
+			}
+		}
+	}
+
+	// Type 4: a value of the pair locked in the roof of a house, the other value is removed from the roof.
+	for _, house := range houses {
+		for _, value := range pair.Digits() {
+			if len(grid.GetPositionsWithCandidate(house, value)) != 2 {
+				continue
+			}
+
+			other := pair.Remove(value).First()
+			if step := newStep(UniqueRectangleType4, collectEliminations(grid, roof, other), nil); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find a hidden unique rectangle: a bivalue corner, and strong links on one value of the pair
+// along both lines of the opposite corner. The other value of the pair is removed from the opposite corner.
+func findHiddenUniqueRectangleStep(grid *core.CandidateGrid, pair core.CandidateSet, corners [4]core.Position) *SolveStep {
+	for i, corner := range corners {
+		if grid.GetCandidates(corner) != pair {
+			continue
+		}
+
+		// The corners are ordered so that the opposite corner has the index 3 - i.
+		opposite := corners[3-i]
+		for _, value := range pair.Digits() {
+			row := grid.GetPositionsWithCandidate(core.NewRowHouse(opposite.Row), value)
+			column := grid.GetPositionsWithCandidate(core.NewColumnHouse(opposite.Column), value)
+			if len(row) != 2 || len(column) != 2 {
+				continue
+			}
+
+			other := pair.Remove(value).First()
+			if grid.HasCandidate(opposite, other) {
+				return &SolveStep{
+					Eliminations: []core.Cell{core.NewCell(opposite, other)},
+					Pattern:      UniquenessPattern{Type: HiddenUniqueRectangle, Values: pair, Positions: corners[:]},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find a BUG+1: all the empty cells are bivalue but one with three candidates,
+// and removing one value from it leaves every value exactly twice in every house.
+// That value must then be placed in the cell, otherwise the puzzle would have several solutions.
+func findBivalueUniversalGraveStep(grid *core.CandidateGrid) *SolveStep {
+	var extraPosition *core.Position
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			if grid.Get(position) != 0 {
+				continue
+			}
+
+			switch grid.GetCandidates(position).Count() {
+			case 2:
+			case 3:
+				if extraPosition != nil {
+					return nil
+				}
+				extraPosition = &position
+			default:
+				return nil
+			}
+		}
+	}
+
+	if extraPosition == nil {
+		return nil
+	}
+
+	for _, value := range grid.GetCandidates(*extraPosition).Digits() {
+		isGrave := true
+		for _, house := range core.AllHouses() {
+			for digit := 1; digit <= 9; digit++ {
+				count := len(grid.GetPositionsWithCandidate(house, digit))
+				if digit == value && house.Contains(*extraPosition) {
+					count--
+				}
+
+				if count != 0 && count != 2 {
+					isGrave = false
+				}
+			}
+		}
+
+		if isGrave {
+			return &SolveStep{
+				Placements: []core.Cell{core.NewCell(*extraPosition, value)},
+				Pattern:    UniquenessPattern{Type: BivalueUniversalGrave, Values: core.NewCandidateSet(value), Positions: []core.Position{*extraPosition}},
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find the next uniqueness step, rectangles first.
+func findUniquenessStep(grid *core.CandidateGrid) *SolveStep {
+	for _, values := range combinations(core.AllCandidates.Digits(), 2) {
+		pair := core.NewCandidateSet(values...)
+		for _, corners := range findUniqueRectangles(grid, pair) {
+			if step := findUniqueRectangleStep(grid, pair, corners); step != nil {
+				return step
+			}
+
+			if step := findHiddenUniqueRectangleStep(grid, pair, corners); step != nil {
+				return step
+			}
+		}
+	}
+
+	return findBivalueUniversalGraveStep(grid)
+}
@@ -0,0 +1,168 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/gnailuy/sudoku/core"
+)
+
+// Define the wings solver object.
+type WingsSolver struct {
+	StrategySolver
+}
+
+// Constructor like function to create a default WingsSolver object.
+func NewWingsSolver() WingsSolver {
+	return WingsSolver{
+		newStrategySolver(BaseSolver{
+			Key:         "wings",
+			DisplayName: "Wings Solver",
+			Description: `Strategy solver using singles and the XY-Wing, XYZ-Wing and W-Wing patterns on cells with two or three candidates.`,
+			Reliable:    false,
+		}, findWingsStep),
+	}
+}
+
+// Define the pattern of a wing.
+type WingPattern struct {
+	Name    string          // The name of the wing: XY-Wing, XYZ-Wing or W-Wing.
+	Value   int             // The value removed from the cells seeing all the pincers.
+	Pivot   *core.Position  // The pivot of the wing, nil for a W-Wing.
+	Pincers []core.Position // The pincers of the wing.
+	Link    *StrongLink     // The strong link connecting the pincers of a W-Wing.
+}
+
+// Function to print the wing pattern.
+func (pattern WingPattern) ToString() string {
+	result := fmt.Sprintf("%s on %d with pincers %s", pattern.Name, pattern.Value, formatPositions(pattern.Pincers))
+	if pattern.Pivot != nil {
+		result += " and pivot " + pattern.Pivot.ToString()
+	}
+
+	if pattern.Link != nil {
+		result += " linked by " + pattern.Link.ToString()
+	}
+
+	return result
+}
+
+// Function to get the empty positions with the given number of candidates.
+func getPositionsWithCandidateCount(grid *core.CandidateGrid, count int) []core.Position {
+	positions := []core.Position{}
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			position := core.NewPosition(row, column)
+			if grid.Get(position) == 0 && grid.GetCandidates(position).Count() == count {
+				positions = append(positions, position)
+			}
+		}
+	}
+
+	return positions
+}
+
+// Function to build a wing step removing the value from the cells seeing all the given positions.
+func newWingStep(grid *core.CandidateGrid, value int, seen []core.Position, pattern WingPattern) *SolveStep {
+	eliminations := collectEliminations(grid, getCommonPeers(grid, seen...), value)
+	if len(eliminations) == 0 {
+		return nil
+	}
+
+	return &SolveStep{Eliminations: eliminations, Pattern: pattern}
+}
+
+// Function to find an XY-Wing or XYZ-Wing: a pivot with candidates {x, y} or {x, y, z},
+// and two bivalue pincers {x, z} and {y, z} seeing the pivot, so z can be removed from the cells seeing all of them.
+func findPivotWing(grid *core.CandidateGrid, pivotSize int) *SolveStep {
+	bivalues := getPositionsWithCandidateCount(grid, 2)
+
+	for _, pivot := range getPositionsWithCandidateCount(grid, pivotSize) {
+		pivotCandidates := grid.GetCandidates(pivot)
+
+		pincers := []core.Position{}
+		for _, position := range bivalues {
+			if pivot.IsPeerOf(position) && grid.GetCandidates(position).Intersect(pivotCandidates).Count() >= 1 {
+				pincers = append(pincers, position)
+			}
+		}
+
+		for _, pair := range combinations(pincers, 2) {
+			first, second := grid.GetCandidates(pair[0]), grid.GetCandidates(pair[1])
+
+			// The pincers share exactly the value z, and together with the pivot they use exactly 3 values.
+			common := first.Intersect(second)
+			if common.Count() != 1 || first == second || first.Union(second).Union(pivotCandidates).Count() != 3 {
+				continue
+			}
+
+			value := common.First()
+			seen := pair
+			name := "XY-Wing"
+
+			if pivotSize == 2 {
+				// For an XY-Wing, z is not a candidate of the pivot.
+				if pivotCandidates.Contains(value) {
+					continue
+				}
+			} else {
+				// For an XYZ-Wing, the eliminated cells must also see the pivot holding z.
+				seen = []core.Position{pair[0], pair[1], pivot}
+				name = "XYZ-Wing"
+			}
+
+			pivotCopy := pivot
+			if step := newWingStep(grid, value, seen, WingPattern{Name: name, Value: value, Pivot: &pivotCopy, Pincers: pair}); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find a W-Wing: two bivalue cells {x, y} not seeing each other, connected by a strong link on x,
+// so one of them must be y, which can be removed from the cells seeing both.
+func findWWing(grid *core.CandidateGrid) *SolveStep {
+	for _, pair := range combinations(getPositionsWithCandidateCount(grid, 2), 2) {
+		candidates := grid.GetCandidates(pair[0])
+		if candidates != grid.GetCandidates(pair[1]) || pair[0].IsPeerOf(pair[1]) {
+			continue
+		}
+
+		for _, linkValue := range candidates.Digits() {
+			value := candidates.Remove(linkValue).First()
+
+			for _, link := range findStrongLinks(grid, linkValue) {
+				if containsPosition(link.Ends[:], pair[0]) || containsPosition(link.Ends[:], pair[1]) {
+					continue
+				}
+
+				connected := (link.Ends[0].IsPeerOf(pair[0]) && link.Ends[1].IsPeerOf(pair[1])) ||
+					(link.Ends[0].IsPeerOf(pair[1]) && link.Ends[1].IsPeerOf(pair[0]))
+				if !connected {
+					continue
+				}
+
+				linkCopy := link
+				if step := newWingStep(grid, value, pair, WingPattern{Name: "W-Wing", Value: value, Pincers: pair, Link: &linkCopy}); step != nil {
+					return step
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to find the next wing step: XY-Wing, then XYZ-Wing, then W-Wing.
+func findWingsStep(grid *core.CandidateGrid) *SolveStep {
+	if step := findPivotWing(grid, 2); step != nil {
+		return step
+	}
+
+	if step := findPivotWing(grid, 3); step != nil {
+		return step
+	}
+
+	return findWWing(grid)
+}
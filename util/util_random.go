@@ -2,8 +2,47 @@ package util
 
 import "math/rand"
 
+// Define the interface of a random source, satisfied by *rand.Rand.
+// A source is not safe for concurrent use unless stated otherwise.
+type IRandomSource interface {
+	Intn(n int) int
+	Float64() float64
+	Shuffle(n int, swap func(i, j int))
+}
+
+// Define the random source using the global functions of math/rand, safe for concurrent use.
+type globalRandomSource struct{}
+
+// Function to get a random number from 0 to n, excluding n, with the global random source.
+func (source globalRandomSource) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+// Function to get a random number from 0 to 1, excluding 1, with the global random source.
+func (source globalRandomSource) Float64() float64 {
+	return rand.Float64()
+}
+
+// Function to shuffle n elements with the swap function, with the global random source.
+func (source globalRandomSource) Shuffle(n int, swap func(i, j int)) {
+	rand.Shuffle(n, swap)
+}
+
+// The default random source, randomly seeded at every run.
+var GlobalRandomSource IRandomSource = globalRandomSource{}
+
+// Constructor like function to create a random source from a seed. The same seed always gives the same sequence.
+func NewSeededRandomSource(seed int64) IRandomSource {
+	return rand.New(rand.NewSource(seed))
+}
+
 // Function to generate numbers from min to max, including min but excluding max, optionally in a random order.
 func GenerateNumberArray(min, max int, randomly bool) []int {
+	return GenerateNumberArrayWith(GlobalRandomSource, min, max, randomly)
+}
+
+// Function to generate numbers from min to max like GenerateNumberArray, shuffled with the random source.
+func GenerateNumberArrayWith(source IRandomSource, min, max int, randomly bool) []int {
 	if min >= max {
 		panic("Bug: Invalid range to generate number array: min >= max")
 	}
@@ -14,7 +53,7 @@ func GenerateNumberArray(min, max int, randomly bool) []int {
 	}
 
 	if randomly {
-		ShuffleArray(numbers)
+		ShuffleArrayWith(source, numbers)
 	}
 
 	return numbers
@@ -22,21 +61,36 @@ func GenerateNumberArray(min, max int, randomly bool) []int {
 
 // Function to shuffle a slice of arrays in place.
 func ShuffleArray[T any](array []T) {
-	rand.Shuffle(len(array), func(i, j int) {
+	ShuffleArrayWith(GlobalRandomSource, array)
+}
+
+// Function to shuffle a slice of arrays in place with the random source.
+func ShuffleArrayWith[T any](source IRandomSource, array []T) {
+	source.Shuffle(len(array), func(i, j int) {
 		array[i], array[j] = array[j], array[i]
 	})
 }
 
 // Function to generate a random number from min to max, including min but excluding max.
 func RandomInt(min, max int) int {
+	return RandomIntWith(GlobalRandomSource, min, max)
+}
+
+// Function to generate a random number from min to max like RandomInt with the random source.
+func RandomIntWith(source IRandomSource, min, max int) int {
 	if min >= max {
 		panic("Bug: Invalid range to generate random number: min >= max")
 	}
 
-	return rand.Intn(max-min) + min
+	return source.Intn(max-min) + min
 }
 
 // Function to return true with a probability of p.
 func RandomBool(p float64) bool {
-	return rand.Float64() < p
+	return RandomBoolWith(GlobalRandomSource, p)
+}
+
+// Function to return true with a probability of p with the random source.
+func RandomBoolWith(source IRandomSource, p float64) bool {
+	return source.Float64() < p
 }